@@ -0,0 +1,14 @@
+# Fetch an asset group by name
+data "cortexcloud_asset_group" "by_name" {
+  name = "AWS Production Application Servers"
+}
+
+# Fetch an asset group by ID
+data "cortexcloud_asset_group" "by_id" {
+  id = 42
+}
+
+# Use the asset group ID in other resources
+output "asset_group_id" {
+  value = data.cortexcloud_asset_group.by_name.id
+}
@@ -0,0 +1,24 @@
+# Fetch all asset groups
+data "cortexcloud_asset_groups" "all" {}
+
+# Fetch all dynamic asset groups with "prod" in their name
+data "cortexcloud_asset_groups" "production" {
+  filter = {
+    and = [
+      {
+        search_field = "XDM.ASSET_GROUP.TYPE"
+        search_type  = "EQ"
+        search_value = "Dynamic"
+      },
+      {
+        search_field = "XDM.ASSET_GROUP.NAME"
+        search_type  = "CONTAINS"
+        search_value = "prod"
+      },
+    ]
+  }
+}
+
+output "production_asset_group_ids" {
+  value = [for group in data.cortexcloud_asset_groups.production.asset_groups : group.id]
+}
@@ -0,0 +1,9 @@
+# Fetch a notification forwarding configuration by ID
+data "cortexcloud_notification_forwarding_config" "audit_logs" {
+  id = "00000000-0000-0000-0000-000000000001"
+}
+
+# Check that the configuration forwards audit logs to the SIEM
+output "audit_logs_forwarded_to_siem" {
+  value = data.cortexcloud_notification_forwarding_config.audit_logs.enabled && data.cortexcloud_notification_forwarding_config.audit_logs.syslog_config != null
+}
@@ -0,0 +1,9 @@
+# Fetch the scope of a user
+data "cortexcloud_scope" "user" {
+  entity_type = "user"
+  entity_id   = "jane.doe@example.com"
+}
+
+output "user_asset_scope_mode" {
+  value = data.cortexcloud_scope.user.assets.mode
+}
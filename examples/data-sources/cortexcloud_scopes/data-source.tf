@@ -0,0 +1,15 @@
+# Fetch the scope of every user and user group
+data "cortexcloud_scopes" "all" {}
+
+# Fetch the scope of every user group
+data "cortexcloud_scopes" "groups" {
+  entity_type = "group"
+}
+
+# List the users and groups that can see all assets
+output "unscoped_asset_access" {
+  value = [
+    for scope in data.cortexcloud_scopes.all.scopes : scope.entity_name
+    if scope.assets.mode == "see_all"
+  ]
+}
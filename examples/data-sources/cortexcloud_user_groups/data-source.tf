@@ -0,0 +1,14 @@
+# Fetch all user groups
+data "cortexcloud_user_groups" "all" {}
+
+# Fetch all custom user groups whose name starts with "SOC" that the given
+# user is a member of
+data "cortexcloud_user_groups" "soc" {
+  group_type   = "custom"
+  name_pattern = "^SOC"
+  user_email   = "jane.doe@example.com"
+}
+
+output "soc_group_ids" {
+  value = [for group in data.cortexcloud_user_groups.soc.user_groups : group.id]
+}
@@ -0,0 +1,14 @@
+# Fetch all users
+data "cortexcloud_users" "all" {}
+
+# Fetch all active users in the "SOC Analysts" user group with an
+# example.com email address
+data "cortexcloud_users" "soc_analysts" {
+  status        = "ACTIVE"
+  group_name    = "SOC Analysts"
+  email_pattern = "@example\\.com$"
+}
+
+output "soc_analyst_emails" {
+  value = [for user in data.cortexcloud_users.soc_analysts.users : user.user_email]
+}
@@ -0,0 +1,4 @@
+# Reads the "prod" profile from ~/.cortexcloud/config
+provider "cortexcloud" {
+  profile = "prod"
+}
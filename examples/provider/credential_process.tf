@@ -0,0 +1,3 @@
+provider "cortexcloud" {
+  credential_process = "vault kv get -format=json -field=data secret/cortexcloud"
+}
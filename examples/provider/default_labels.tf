@@ -0,0 +1,23 @@
+provider "cortexcloud" {
+  api_url    = "https://api-cortexcloud.xdr.us.paloaltonetworks.com"
+  api_key    = var.cortexcloud_api_key
+  api_key_id = var.cortexcloud_api_key_id
+
+  default_labels = ["managed-by:terraform", "team:platform-security"]
+}
+
+resource "cortexcloud_cloudsec_policy" "example" {
+  name = "example-policy"
+
+  # The policy's effective_labels will contain "env:prod", "managed-by:terraform"
+  # and "team:platform-security"
+  labels = ["env:prod"]
+
+  rule_matching = {
+    type = "ALL_RULES"
+  }
+
+  asset_matching = {
+    type = "ALL_ASSETS"
+  }
+}
@@ -0,0 +1,10 @@
+provider "cortexcloud" {
+  api_url    = "https://api-cortexcloud.xdr.us.paloaltonetworks.com"
+  api_key    = var.cortexcloud_api_key
+  api_key_id = var.cortexcloud_api_key_id
+
+  endpoints {
+    cloudsec = "https://cloudsec-gateway.staging.example.com"
+    platform = "http://localhost:8080"
+  }
+}
@@ -0,0 +1,8 @@
+provider "cortexcloud" {
+  api_url    = "https://api-cortexcloud.xdr.us.paloaltonetworks.com"
+  api_key    = var.cortexcloud_api_key
+  api_key_id = var.cortexcloud_api_key_id
+
+  detect_external_changes = true
+  api_key_identity        = "terraform-automation"
+}
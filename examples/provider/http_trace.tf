@@ -0,0 +1,7 @@
+provider "cortexcloud" {
+  api_url    = "https://api-cortexcloud.xdr.us.paloaltonetworks.com"
+  api_key    = var.cortexcloud_api_key
+  api_key_id = var.cortexcloud_api_key_id
+
+  http_trace_file = "./cortexcloud-http-trace.jsonl"
+}
@@ -0,0 +1,12 @@
+provider "cortexcloud" {
+  api_url    = "https://api-cortexcloud.xdr.us.paloaltonetworks.com"
+  api_key    = var.cortexcloud_api_key
+  api_key_id = var.cortexcloud_api_key_id
+
+  proxy_url    = "http://proxy.corp.example.com:3128"
+  ca_cert_file = "/etc/ssl/certs/corp-root-ca.pem"
+
+  # Only required if the proxy or gateway requires mutual TLS authentication
+  client_cert = "/etc/cortexcloud/client.crt"
+  client_key  = "/etc/cortexcloud/client.key"
+}
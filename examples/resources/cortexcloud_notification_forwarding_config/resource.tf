@@ -0,0 +1,23 @@
+# Forward issues by email
+resource "cortexcloud_notification_forwarding_config" "issues" {
+  name = "Forward issues to the SOC"
+  type = "issues"
+  email_config = {
+    distribution_list = ["soc@example.com"]
+    format            = "standard_alert"
+  }
+}
+
+# Consolidate an existing typed resource without recreating the configuration
+moved {
+  from = cortexcloud_notification_forwarding_config_agent_audit_logs.siem
+  to   = cortexcloud_notification_forwarding_config.agent_audit_logs
+}
+
+resource "cortexcloud_notification_forwarding_config" "agent_audit_logs" {
+  name = "Forward agent audit logs to SIEM"
+  type = "agent_audit_logs"
+  syslog_config = {
+    server_id = 42
+  }
+}
@@ -9,16 +9,16 @@ resource "cortexcloud_vulnerability_policy" "critical_vulns" {
   severity          = "CRITICAL"
   asset_group_scope = [1]
 
-  # Match criteria as JSON-encoded string
-  match_criteria = jsonencode({
-    AND = [
+  # Match all critical severity vulnerabilities
+  match_criteria_filter = {
+    and = [
       {
-        SEARCH_FIELD = "SEVERITY"
-        SEARCH_TYPE  = "EQ"
-        SEARCH_VALUE = "CRITICAL"
+        search_field = "SEVERITY"
+        search_type  = "EQ"
+        search_value = "CRITICAL"
       }
     ]
-  })
+  }
 
   # Define actions to take
   action {
@@ -39,31 +39,31 @@ resource "cortexcloud_vulnerability_policy" "high_vulns_with_exclusions" {
   severity          = "HIGH"
   asset_group_scope = [1, 2]
 
-  match_criteria = jsonencode({
-    AND = [
+  match_criteria_filter = {
+    and = [
       {
-        SEARCH_FIELD = "SEVERITY"
-        SEARCH_TYPE  = "EQ"
-        SEARCH_VALUE = "HIGH"
+        search_field = "SEVERITY"
+        search_type  = "EQ"
+        search_value = "HIGH"
       }
     ]
-  })
+  }
 
   # Exclude test environments
-  exclusion_criteria = jsonencode({
-    OR = [
+  exclusion_criteria_filter = {
+    or = [
       {
-        SEARCH_FIELD = "ASSET_TAG"
-        SEARCH_TYPE  = "CONTAINS"
-        SEARCH_VALUE = "test"
+        search_field = "ASSET_TAG"
+        search_type  = "CONTAINS"
+        search_value = "test"
       },
       {
-        SEARCH_FIELD = "ASSET_TAG"
-        SEARCH_TYPE  = "CONTAINS"
-        SEARCH_VALUE = "dev"
+        search_field = "ASSET_TAG"
+        search_type  = "CONTAINS"
+        search_value = "dev"
       }
     ]
-  })
+  }
 
   action {
     action_type       = "alert"
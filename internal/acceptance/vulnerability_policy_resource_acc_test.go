@@ -114,6 +114,7 @@ func TestAccVulnerabilityPolicyResourceLifecycle(t *testing.T) {
 					resource.TestCheckResourceAttr(vulnerabilityPolicyResourceNameFull, "asset_group_scope.#", "1"),
 					resource.TestCheckResourceAttrSet(vulnerabilityPolicyResourceNameFull, "id"),
 					resource.TestCheckResourceAttrSet(vulnerabilityPolicyResourceNameFull, "match_criteria"),
+					resource.TestCheckResourceAttr(vulnerabilityPolicyResourceNameFull, "match_criteria_filter.and.0.search_field", "SEVERITY"),
 				),
 			},
 			// Update and Read testing
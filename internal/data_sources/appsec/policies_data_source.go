@@ -72,10 +72,12 @@ func (d *policiesDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 						},
 						"conditions": schema.StringAttribute{
 							Description: "Policy conditions as a JSON-encoded string with nested AND/OR logic.",
+							CustomType:  util.NormalizedJSONType{},
 							Computed:    true,
 						},
 						"scope": schema.StringAttribute{
 							Description: "Asset targeting scope as a JSON-encoded string with nested AND/OR logic.",
+							CustomType:  util.NormalizedJSONType{},
 							Computed:    true,
 						},
 						"asset_group_ids": schema.ListAttribute{
@@ -128,11 +130,12 @@ func (d *policiesDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	d.client = client.AppSec
+	d.client = client.AppSec.Client(ctx, &resp.Diagnostics)
 }
 
 func (d *policiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var config appsecModels.PoliciesDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -59,10 +59,12 @@ func (d *policyDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			},
 			"conditions": schema.StringAttribute{
 				Description: "Policy conditions as a JSON-encoded string with nested AND/OR logic.",
+				CustomType:  util.NormalizedJSONType{},
 				Computed:    true,
 			},
 			"scope": schema.StringAttribute{
 				Description: "Asset targeting scope as a JSON-encoded string with nested AND/OR logic.",
+				CustomType:  util.NormalizedJSONType{},
 				Computed:    true,
 			},
 			"asset_group_ids": schema.ListAttribute{
@@ -249,11 +251,12 @@ func (d *policyDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	d.client = client.AppSec
+	d.client = client.AppSec.Client(ctx, &resp.Diagnostics)
 }
 
 func (d *policyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var config appsecModels.PolicyModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -94,6 +94,11 @@ func (d *ruleDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"effective_labels": schema.SetAttribute{
+				Description: "All labels assigned to the rule, including the labels added by the provider's `default_labels` attribute.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"is_custom": schema.BoolAttribute{
 				Description: "Indicates whether the rule is custom.",
 				Computed:    true,
@@ -137,11 +142,12 @@ func (d *ruleDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	d.client = client.AppSec
+	d.client = client.AppSec.Client(ctx, &resp.Diagnostics)
 }
 
 func (d *ruleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var config appsecModels.RuleModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -62,11 +62,12 @@ func (d *ruleLabelsDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	d.client = client.AppSec
+	d.client = client.AppSec.Client(ctx, &resp.Diagnostics)
 }
 
 func (d *ruleLabelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	result, err := d.client.GetLabels(ctx)
 	if err != nil {
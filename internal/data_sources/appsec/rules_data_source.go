@@ -114,6 +114,11 @@ func (d *rulesDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 							Computed:    true,
 							ElementType: types.StringType,
 						},
+						"effective_labels": schema.SetAttribute{
+							Description: "All labels assigned to the rule, including the labels added by the provider's `default_labels` attribute.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
 						"is_custom": schema.BoolAttribute{
 							Description: "Indicates whether the rule is custom.",
 							Computed:    true,
@@ -160,11 +165,12 @@ func (d *rulesDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	d.client = client.AppSec
+	d.client = client.AppSec.Client(ctx, &resp.Diagnostics)
 }
 
 func (d *rulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var config appsecModels.RulesDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -309,12 +309,13 @@ func (r *CloudIntegrationInstanceDataSource) Configure(ctx context.Context, req
 		return
 	}
 
-	r.client = client.CloudOnboarding
+	r.client = client.CloudOnboarding.Client(ctx, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *CloudIntegrationInstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	ctx = tflog.SetField(ctx, "data_source_type", "CloudIntegrationInstanceDataSource")
 	ctx = tflog.SetField(ctx, "data_source_id_field", "id")
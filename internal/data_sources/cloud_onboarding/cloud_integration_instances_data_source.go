@@ -273,12 +273,13 @@ func (d *CloudIntegrationInstancesDataSource) Configure(ctx context.Context, req
 		return
 	}
 
-	d.client = client.CloudOnboarding
+	d.client = client.CloudOnboarding.Client(ctx, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *CloudIntegrationInstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	ctx = tflog.SetField(ctx, "data_source_type", "CloudIntegrationInstancesDataSource")
 
@@ -73,12 +73,13 @@ func (d *OutpostDataSource) Configure(ctx context.Context, req datasource.Config
 		util.AddUnexpectedDataSourceConfigurationTypeError(&resp.Diagnostics, "*providerModels.CortexCloudSDKClients", req.ProviderData)
 		return
 	}
-	d.client = client.CloudOnboarding
+	d.client = client.CloudOnboarding.Client(ctx, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *OutpostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	ctx = tflog.SetField(ctx, "data_source_type", "OutpostDataSource")
 
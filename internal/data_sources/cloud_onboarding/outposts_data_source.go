@@ -98,12 +98,13 @@ func (d *OutpostsDataSource) Configure(ctx context.Context, req datasource.Confi
 		util.AddUnexpectedDataSourceConfigurationTypeError(&resp.Diagnostics, "*providerModels.CortexCloudSDKClients", req.ProviderData)
 		return
 	}
-	d.client = client.CloudOnboarding
+	d.client = client.CloudOnboarding.Client(ctx, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *OutpostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	ctx = tflog.SetField(ctx, "data_source_type", "OutpostsDataSource")
 
@@ -97,6 +97,11 @@ func (d *CloudSecPolicyDataSource) Schema(_ context.Context, _ datasource.Schema
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"effective_labels": schema.SetAttribute{
+				Description: "All labels assigned to the policy, including the labels added by the provider's `default_labels` attribute.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"rule_matching": schema.SingleNestedAttribute{
 				Description: "Rule matching configuration.",
 				Computed:    true,
@@ -166,7 +171,7 @@ func (d *CloudSecPolicyDataSource) Schema(_ context.Context, _ datasource.Schema
 }
 
 // Configure adds the provider-configured client to the data source.
-func (d *CloudSecPolicyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+func (d *CloudSecPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
@@ -177,12 +182,13 @@ func (d *CloudSecPolicyDataSource) Configure(_ context.Context, req datasource.C
 		return
 	}
 
-	d.client = clients.CloudSec
+	d.client = clients.CloudSec.Client(ctx, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *CloudSecPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var config cloudsecModels.CloudSecPolicyResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
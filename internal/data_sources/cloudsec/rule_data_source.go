@@ -128,6 +128,11 @@ func (d *CloudSecRuleDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"effective_labels": schema.SetAttribute{
+				Description: "All labels assigned to the rule, including the labels added by the provider's `default_labels` attribute.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"enabled": schema.BoolAttribute{
 				Description: "Whether the rule is enabled.",
 				Computed:    true,
@@ -174,7 +179,7 @@ func (d *CloudSecRuleDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 }
 
 // Configure adds the provider-configured client to the data source.
-func (d *CloudSecRuleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+func (d *CloudSecRuleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
@@ -185,12 +190,13 @@ func (d *CloudSecRuleDataSource) Configure(_ context.Context, req datasource.Con
 		return
 	}
 
-	d.client = clients.CloudSec
+	d.client = clients.CloudSec.Client(ctx, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *CloudSecRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var config cloudsecModels.CloudSecRuleResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
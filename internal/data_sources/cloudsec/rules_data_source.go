@@ -193,7 +193,7 @@ func (d *CloudSecRulesDataSource) Schema(_ context.Context, _ datasource.SchemaR
 }
 
 // Configure adds the provider-configured client to the data source.
-func (d *CloudSecRulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+func (d *CloudSecRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
@@ -204,7 +204,7 @@ func (d *CloudSecRulesDataSource) Configure(_ context.Context, req datasource.Co
 		return
 	}
 
-	d.client = clients.CloudSec
+	d.client = clients.CloudSec.Client(ctx, &resp.Diagnostics)
 }
 
 // rulesFilterModel represents a simple filter for the rules data source.
@@ -228,6 +228,7 @@ type CloudSecRulesDataSourceModel struct {
 // Read refreshes the Terraform state with the latest data.
 func (d *CloudSecRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	ctx = tflog.SetField(ctx, "data_source_type", "CloudSecRulesDataSource")
 
@@ -68,11 +68,12 @@ func (d *assessmentProfileDataSource) Configure(ctx context.Context, req datasou
 		return
 	}
 
-	d.client = client.Compliance
+	d.client = client.Compliance.Client(ctx, &resp.Diagnostics)
 }
 
 func (d *assessmentProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var config complianceModels.AssessmentProfileModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -152,11 +152,12 @@ func (d *assessmentProfilesDataSource) Configure(ctx context.Context, req dataso
 		return
 	}
 
-	d.client = client.Compliance
+	d.client = client.Compliance.Client(ctx, &resp.Diagnostics)
 }
 
 func (d *assessmentProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var config complianceModels.AssessmentProfilesDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -122,11 +122,12 @@ func (d *controlDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	d.client = client.Compliance
+	d.client = client.Compliance.Client(ctx, &resp.Diagnostics)
 }
 
 func (d *controlDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	ctx = tflog.SetField(ctx, "data_source_type", "ComplianceControlDataSource")
 
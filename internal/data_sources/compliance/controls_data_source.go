@@ -155,11 +155,12 @@ func (d *controlsDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	d.client = client.Compliance
+	d.client = client.Compliance.Client(ctx, &resp.Diagnostics)
 }
 
 func (d *controlsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var config complianceModels.ControlsDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -68,6 +68,11 @@ func (d *standardDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"effective_labels": schema.SetAttribute{
+				Description: "All labels assigned to the standard, including the labels added by the provider's `default_labels` attribute.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"revision": schema.Int64Attribute{
 				Description: "The revision number of the standard.",
 				Computed:    true,
@@ -115,11 +120,12 @@ func (d *standardDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	d.client = client.Compliance
+	d.client = client.Compliance.Client(ctx, &resp.Diagnostics)
 }
 
 func (d *standardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var config complianceModels.StandardModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
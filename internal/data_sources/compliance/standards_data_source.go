@@ -84,6 +84,11 @@ func (d *standardsDataSource) Schema(ctx context.Context, req datasource.SchemaR
 							Computed:    true,
 							ElementType: types.StringType,
 						},
+						"effective_labels": schema.SetAttribute{
+							Description: "All labels assigned to the standard, including the labels added by the provider's `default_labels` attribute.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
 						"revision": schema.Int64Attribute{
 							Description: "The revision number of the standard. This value increments on every update.",
 							Computed:    true,
@@ -153,11 +158,12 @@ func (d *standardsDataSource) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	d.client = client.Compliance
+	d.client = client.Compliance.Client(ctx, &resp.Diagnostics)
 }
 
 func (d *standardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var config complianceModels.StandardsDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
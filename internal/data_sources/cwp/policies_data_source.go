@@ -148,14 +148,17 @@ func (d *policiesDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 						},
 						"condition": schema.StringAttribute{
 							Description: "The condition in blob form.",
+							CustomType:  util.NormalizedJSONType{},
 							Computed:    true,
 						},
 						"exception": schema.StringAttribute{
 							Description: "The exception in blob form.",
+							CustomType:  util.NormalizedJSONType{},
 							Computed:    true,
 						},
 						"asset_scope": schema.StringAttribute{
 							Description: "The asset scope in blob form.",
+							CustomType:  util.NormalizedJSONType{},
 							Computed:    true,
 						},
 						"asset_group_ids": schema.ListAttribute{
@@ -202,12 +205,13 @@ func (d *policiesDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	d.client = client.CWP
+	d.client = client.CWP.Client(ctx, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *policiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	ctx = tflog.SetField(ctx, "data_source_type", "CWPPoliciesDataSource")
 
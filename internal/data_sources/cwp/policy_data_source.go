@@ -134,14 +134,17 @@ func (d *policyDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			},
 			"condition": schema.StringAttribute{
 				Description: "The condition in blob form (base64 encoded).",
+				CustomType:  util.NormalizedJSONType{},
 				Computed:    true,
 			},
 			"exception": schema.StringAttribute{
 				Description: "The exception in blob form (base64 encoded).",
+				CustomType:  util.NormalizedJSONType{},
 				Computed:    true,
 			},
 			"asset_scope": schema.StringAttribute{
 				Description: "The asset scope in blob form (base64 encoded).",
+				CustomType:  util.NormalizedJSONType{},
 				Computed:    true,
 			},
 			"asset_group_ids": schema.ListAttribute{
@@ -185,12 +188,13 @@ func (d *policyDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	d.client = client.CWP
+	d.client = client.CWP.Client(ctx, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *policyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	ctx = tflog.SetField(ctx, "data_source_type", "CWPPolicyDataSource")
 
@@ -0,0 +1,188 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	cortexEnums "github.com/PaloAltoNetworks/cortex-cloud-go/enums"
+	"github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+	filterTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/filter"
+	platformTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/platform"
+	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/platform"
+	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	sharedModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/shared"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AssetGroupDataSource{}
+	_ datasource.DataSourceWithConfigure = &AssetGroupDataSource{}
+)
+
+// NewAssetGroupDataSource is a helper function to simplify the provider implementation.
+func NewAssetGroupDataSource() datasource.DataSource {
+	return &AssetGroupDataSource{}
+}
+
+// AssetGroupDataSource implements data "cortexcloud_asset_group"
+type AssetGroupDataSource struct {
+	client *platform.Client
+}
+
+// Metadata returns the data source type name.
+func (r *AssetGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_group"
+}
+
+// Schema defines the schema for the data source.
+func (r *AssetGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides details about an existing asset group. Exactly one of `id` or `name` must be configured.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The ID of the asset group to look up.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(
+						path.MatchRoot("name"),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the asset group to look up.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the asset group.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the asset group.",
+				Computed:    true,
+			},
+			"membership_predicate": schema.SingleNestedAttribute{
+				Description: "The membership predicate for the asset group.",
+				Computed:    true,
+				Attributes:  sharedModels.RootFilterComputedDataSourceAttributes,
+			},
+			"creation_time": schema.Int64Attribute{
+				Description: "The creation time of the asset group.",
+				Computed:    true,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "The user who created the asset group.",
+				Computed:    true,
+			},
+			"last_update_time": schema.Int64Attribute{
+				Description: "The last update time of the asset group.",
+				Computed:    true,
+			},
+			"modified_by": schema.StringAttribute{
+				Description: "The user who last modified the asset group.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (r *AssetGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerModels.CortexCloudSDKClients)
+	if !ok {
+		util.AddUnexpectedDataSourceConfigurationTypeError(&resp.Diagnostics, "*providerModels.CortexCloudSDKClients", req.ProviderData)
+		return
+	}
+
+	r.client = clients.Platform.Client(ctx, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AssetGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	var config models.AssetGroupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the asset group by ID if configured, otherwise by name
+	byID := !config.ID.IsNull() && !config.ID.IsUnknown()
+	lookupAttribute := path.Root("name")
+	searchField := "XDM.ASSET_GROUP.NAME"
+	searchValue := config.Name.ValueString()
+	if byID {
+		lookupAttribute = path.Root("id")
+		searchField = "XDM.ASSET_GROUP.ID"
+		searchValue = strconv.FormatInt(config.ID.ValueInt64(), 10)
+	}
+
+	listReq := platformTypes.ListAssetGroupsRequest{
+		Filters: filterTypes.NewSearchFilter(
+			searchField,
+			cortexEnums.SearchTypeEqualTo.String(),
+			searchValue,
+		),
+	}
+	assetGroups, err := r.client.ListAssetGroups(ctx, listReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Asset Group", err.Error())
+		return
+	}
+
+	// The API may perform a case-insensitive match on the search value, so
+	// only keep exact matches
+	var matches []platformTypes.AssetGroup
+	for _, assetGroup := range assetGroups {
+		if (byID && strconv.Itoa(assetGroup.ID) == searchValue) || (!byID && assetGroup.Name == searchValue) {
+			matches = append(matches, assetGroup)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			lookupAttribute,
+			"Asset Group Not Found",
+			fmt.Sprintf("Asset group with %s %s not found", lookupAttribute.String(), strconv.Quote(searchValue)),
+		)
+		return
+	}
+
+	if len(matches) > 1 {
+		resp.Diagnostics.AddAttributeError(
+			lookupAttribute,
+			"Multiple Asset Groups Found",
+			fmt.Sprintf("Found %d asset groups with %s %s. Use the id attribute to select a specific asset group.", len(matches), lookupAttribute.String(), strconv.Quote(searchValue)),
+		)
+		return
+	}
+
+	config.RefreshFromRemote(ctx, &resp.Diagnostics, &matches[0])
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,209 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	testAssetGroupTmpl = `
+		{
+			"XDM.ASSET_GROUP.ID": %d,
+			"XDM.ASSET_GROUP.NAME": "%s",
+			"XDM.ASSET_GROUP.TYPE": "%s",
+			"XDM.ASSET_GROUP.DESCRIPTION": "%s",
+			"XDM.ASSET_GROUP.CREATION_TIME": 1678886400000,
+			"XDM.ASSET_GROUP.CREATED_BY": "test-user",
+			"XDM.ASSET_GROUP.LAST_UPDATE_TIME": 1678972800000,
+			"XDM.ASSET_GROUP.MODIFIED_BY": "other-user"
+		}`
+
+	testAssetGroupsResponseTmpl = `{
+		"reply": {
+			"data": [%s],
+			"filter_count": %d,
+			"total_count": %d
+		}
+	}`
+)
+
+// assetGroupServer is a mock of the asset group list endpoint that returns a
+// fixed set of asset groups and records the body of the last request.
+type assetGroupServer struct {
+	mu          sync.Mutex
+	requestBody string
+}
+
+func (s *assetGroupServer) lastRequestBody() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requestBody
+}
+
+func newAssetGroupServer(t *testing.T, assetGroups ...string) (*assetGroupServer, *httptest.Server) {
+	t.Helper()
+
+	mock := &assetGroupServer{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		for strings.Contains(path, "//") {
+			path = strings.ReplaceAll(path, "//", "/")
+		}
+		if strings.HasSuffix(path, "/") && path != "/" {
+			path = strings.TrimSuffix(path, "/")
+		}
+
+		switch {
+		case strings.Contains(path, "asset-groups") && r.Method == http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			mock.mu.Lock()
+			mock.requestBody = string(body)
+			mock.mu.Unlock()
+
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, testAssetGroupsResponseTmpl, strings.Join(assetGroups, ","), len(assetGroups), len(assetGroups))
+		default:
+			http.Error(w, fmt.Sprintf("[%s] Endpoint not found: %s %s", t.Name(), r.Method, path), http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return mock, server
+}
+
+func testAssetGroupDataSourceConfig(serverURL, lookup string) string {
+	return fmt.Sprintf(`
+		provider "cortexcloud" {
+			api_url    = "%s"
+			api_key    = "test"
+			api_key_id = 123
+		}
+
+		data "cortexcloud_asset_group" "test" {
+			%s
+		}
+	`, serverURL, lookup)
+}
+
+func TestUnitAssetGroupDataSource_ReadByID(t *testing.T) {
+	mock, server := newAssetGroupServer(t,
+		fmt.Sprintf(testAssetGroupTmpl, 10, "Production", "Dynamic", "Production assets"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAssetGroupDataSourceConfig(server.URL, `id = 10`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_group.test", "id", "10"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_group.test", "name", "Production"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_group.test", "type", "Dynamic"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_group.test", "description", "Production assets"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_group.test", "creation_time", "1678886400000"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_group.test", "created_by", "test-user"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_group.test", "last_update_time", "1678972800000"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_group.test", "modified_by", "other-user"),
+					func(_ *terraform.State) error {
+						if body := mock.lastRequestBody(); !strings.Contains(body, "XDM.ASSET_GROUP.ID") {
+							return fmt.Errorf("expected the request to filter on XDM.ASSET_GROUP.ID, got %s", body)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitAssetGroupDataSource_ReadByName(t *testing.T) {
+	mock, server := newAssetGroupServer(t,
+		// The API matches names case-insensitively, so the data source
+		// must select the exact match from the results
+		fmt.Sprintf(testAssetGroupTmpl, 10, "production", "Static", ""),
+		fmt.Sprintf(testAssetGroupTmpl, 11, "Production", "Dynamic", "Production assets"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAssetGroupDataSourceConfig(server.URL, `name = "Production"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_group.test", "id", "11"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_group.test", "name", "Production"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_group.test", "type", "Dynamic"),
+					func(_ *terraform.State) error {
+						if body := mock.lastRequestBody(); !strings.Contains(body, "XDM.ASSET_GROUP.NAME") {
+							return fmt.Errorf("expected the request to filter on XDM.ASSET_GROUP.NAME, got %s", body)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitAssetGroupDataSource_NotFound(t *testing.T) {
+	_, server := newAssetGroupServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAssetGroupDataSourceConfig(server.URL, `name = "Missing"`),
+				ExpectError: regexp.MustCompile(`Asset Group Not Found`),
+			},
+		},
+	})
+}
+
+func TestUnitAssetGroupDataSource_MultipleFound(t *testing.T) {
+	_, server := newAssetGroupServer(t,
+		fmt.Sprintf(testAssetGroupTmpl, 10, "Production", "Dynamic", ""),
+		fmt.Sprintf(testAssetGroupTmpl, 11, "Production", "Static", ""),
+	)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAssetGroupDataSourceConfig(server.URL, `name = "Production"`),
+				ExpectError: regexp.MustCompile(`Multiple Asset Groups Found`),
+			},
+			{
+				Config:      testAssetGroupDataSourceConfig(server.URL, "id = 10\nname = \"Production\""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/platform"
+	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	sharedModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/shared"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AssetGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &AssetGroupsDataSource{}
+)
+
+// NewAssetGroupsDataSource is a helper function to simplify the provider implementation.
+func NewAssetGroupsDataSource() datasource.DataSource {
+	return &AssetGroupsDataSource{}
+}
+
+// AssetGroupsDataSource implements data "cortexcloud_asset_groups"
+type AssetGroupsDataSource struct {
+	client *platform.Client
+}
+
+// Metadata returns the data source type name.
+func (r *AssetGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_groups"
+}
+
+// Schema defines the schema for the data source.
+func (r *AssetGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a filtered list of asset groups.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Static identifier for the data source.",
+				Computed:    true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Filter criteria for the asset groups, using the same structure as the `membership_predicate` attribute of the `cortexcloud_asset_group` resource. Searchable fields include `XDM.ASSET_GROUP.ID`, `XDM.ASSET_GROUP.NAME`, `XDM.ASSET_GROUP.TYPE` and `XDM.ASSET_GROUP.DESCRIPTION`. If not specified, all asset groups are returned.",
+				Optional:    true,
+				Attributes:  sharedModels.RootFilterDataSourceAttributes,
+			},
+			"asset_groups": schema.ListNestedAttribute{
+				Description: "The list of asset groups matching the filter.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the asset group.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the asset group.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the asset group.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the asset group.",
+							Computed:    true,
+						},
+						"membership_predicate": schema.SingleNestedAttribute{
+							Description: "The membership predicate for the asset group.",
+							Computed:    true,
+							Attributes:  sharedModels.RootFilterComputedDataSourceAttributes,
+						},
+						"creation_time": schema.Int64Attribute{
+							Description: "The creation time of the asset group.",
+							Computed:    true,
+						},
+						"created_by": schema.StringAttribute{
+							Description: "The user who created the asset group.",
+							Computed:    true,
+						},
+						"last_update_time": schema.Int64Attribute{
+							Description: "The last update time of the asset group.",
+							Computed:    true,
+						},
+						"modified_by": schema.StringAttribute{
+							Description: "The user who last modified the asset group.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (r *AssetGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerModels.CortexCloudSDKClients)
+	if !ok {
+		util.AddUnexpectedDataSourceConfigurationTypeError(&resp.Diagnostics, "*providerModels.CortexCloudSDKClients", req.ProviderData)
+		return
+	}
+
+	r.client = clients.Platform.Client(ctx, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AssetGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	tflog.Debug(ctx, "Reading asset groups data source")
+
+	var config models.AssetGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listReq := config.ToListRequest(ctx)
+
+	assetGroups, err := r.client.ListAssetGroups(ctx, listReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Asset Groups", err.Error())
+		return
+	}
+
+	config.RefreshFromRemote(ctx, &resp.Diagnostics, assetGroups)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitAssetGroupsDataSource_Read(t *testing.T) {
+	mock, server := newAssetGroupServer(t,
+		fmt.Sprintf(testAssetGroupTmpl, 10, "Production", "Dynamic", "Production assets"),
+		fmt.Sprintf(testAssetGroupTmpl, 11, "Staging", "Dynamic", "Staging assets"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "cortexcloud" {
+						api_url    = "%s"
+						api_key    = "test"
+						api_key_id = 123
+					}
+
+					data "cortexcloud_asset_groups" "test" {
+						filter = {
+							and = [
+								{
+									search_field = "XDM.ASSET_GROUP.TYPE"
+									search_type  = "EQ"
+									search_value = "Dynamic"
+								}
+							]
+						}
+					}
+				`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_groups.test", "id", "asset_groups"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_groups.test", "asset_groups.#", "2"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_groups.test", "asset_groups.0.id", "10"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_groups.test", "asset_groups.0.name", "Production"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_groups.test", "asset_groups.0.description", "Production assets"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_groups.test", "asset_groups.1.id", "11"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_groups.test", "asset_groups.1.name", "Staging"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_groups.test", "asset_groups.1.type", "Dynamic"),
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_groups.test", "asset_groups.1.created_by", "test-user"),
+					func(_ *terraform.State) error {
+						body := mock.lastRequestBody()
+						if !strings.Contains(body, "XDM.ASSET_GROUP.TYPE") || !strings.Contains(body, "Dynamic") {
+							return fmt.Errorf("expected the request to include the configured filter, got %s", body)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitAssetGroupsDataSource_ReadWithoutFilter(t *testing.T) {
+	_, server := newAssetGroupServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "cortexcloud" {
+						api_url    = "%s"
+						api_key    = "test"
+						api_key_id = 123
+					}
+
+					data "cortexcloud_asset_groups" "test" {}
+				`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cortexcloud_asset_groups.test", "asset_groups.#", "0"),
+				),
+			},
+		},
+	})
+}
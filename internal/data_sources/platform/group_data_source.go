@@ -133,12 +133,13 @@ func (r *GroupDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	r.client = clients.Platform
+	r.client = clients.Platform.Client(ctx, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var config models.UserGroupModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -125,12 +125,13 @@ func (d *iamPermissionConfigDataSource) Configure(ctx context.Context, req datas
 		return
 	}
 
-	d.client = client.Platform
+	d.client = client.Platform.Client(ctx, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *iamPermissionConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state models.IamPermissionConfigModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
@@ -91,12 +91,13 @@ func (r *RoleDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	r.client = client.Platform
+	r.client = client.Platform.Client(ctx, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *RoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var config models.IamRoleDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
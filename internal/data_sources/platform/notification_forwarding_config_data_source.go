@@ -0,0 +1,163 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/PaloAltoNetworks/cortex-cloud-go/enums"
+	platformsdk "github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+
+	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/platform"
+	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	sharedModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/shared"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &notificationForwardingConfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &notificationForwardingConfigDataSource{}
+)
+
+// NewNotificationForwardingConfigDataSource is a helper function to simplify the provider implementation.
+func NewNotificationForwardingConfigDataSource() datasource.DataSource {
+	return &notificationForwardingConfigDataSource{}
+}
+
+// notificationForwardingConfigDataSource is the data source implementation.
+type notificationForwardingConfigDataSource struct {
+	client *platformsdk.Client
+}
+
+// Metadata returns the data source type name.
+func (d *notificationForwardingConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_forwarding_config"
+}
+
+// Schema defines the schema for the data source.
+func (d *notificationForwardingConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides details about an existing notification forwarding configuration of any type.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the configuration.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("The type of notifications forwarded by the configuration. One of \"%s\".", strings.Join(models.NotificationForwardingConfigurationTypeEnums, `", "`)),
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the configuration.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the configuration.",
+				Computed:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "The status of the configuration.",
+				Computed:    true,
+			},
+			"scope": schema.SingleNestedAttribute{
+				Description: "The filter for the configuration.",
+				Computed:    true,
+				Attributes:  sharedModels.RootFilterComputedDataSourceAttributes,
+			},
+			"email_config": schema.SingleNestedAttribute{
+				Description: "The email notification forwarding settings of the configuration.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"distribution_list": schema.ListAttribute{
+						Description: "The email addresses that notifications are sent to.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"subject": schema.StringAttribute{
+						Description: "The subject used for notification emails. Empty if Cortex Cloud generates the subject.",
+						Computed:    true,
+					},
+					"format": schema.StringAttribute{
+						Description: fmt.Sprintf("The format used for the email body. One of \"%s\". Only set when `type` is \"%s\".", strings.Join(enums.AllNotificationFormats(), `", "`), models.NotificationForwardingConfigurationTypeIssues),
+						Computed:    true,
+					},
+					"grouping_timeframe": schema.Int32Attribute{
+						Description: "The time frame, in minutes, that specifies how often Cortex Cloud sends notifications. 0 if a notification is sent for each event.",
+						Computed:    true,
+					},
+				},
+			},
+			"syslog_config": schema.SingleNestedAttribute{
+				Description: "The syslog notification forwarding settings of the configuration.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"server_id": schema.Int64Attribute{
+						Description: "The ID of the syslog server that notifications are forwarded to.",
+						Computed:    true,
+					},
+					"format": schema.StringAttribute{
+						Description: fmt.Sprintf("The format used for the syslog message body. One of \"%s\". Only set when `type` is \"%s\".", strings.Join(enums.AllNotificationFormats(), `", "`), models.NotificationForwardingConfigurationTypeIssues),
+						Computed:    true,
+					},
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Description: "The timezone used by the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *notificationForwardingConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerModels.CortexCloudSDKClients)
+	if !ok {
+		util.AddUnexpectedDataSourceConfigurationTypeError(&resp.Diagnostics, "*providerModels.CortexCloudSDKClients", req.ProviderData)
+		return
+	}
+
+	d.client = client.Platform.Client(ctx, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *notificationForwardingConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	var id types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read the configuration from the API.
+	remote, err := d.client.GetNotificationForwardingConfiguration(ctx, id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Notification Forwarding Configuration", err.Error())
+		return
+	}
+
+	// Refresh the model from the remote object.
+	var data models.NotificationForwardingConfigurationModel
+	data.RefreshFromRemote(ctx, &resp.Diagnostics, remote)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the state.
+	data.ToTfsdk(ctx, &resp.Diagnostics, &resp.State)
+}
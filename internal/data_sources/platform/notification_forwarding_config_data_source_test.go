@@ -0,0 +1,158 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/cortex-cloud-go/enums"
+	sdk "github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testNotificationForwardingConfigID = "00000000-0000-0000-0000-000000000001"
+
+// newNotificationForwardingConfigServer returns a mock server that responds
+// to the notification forwarding configuration endpoint with a single issues
+// configuration forwarded by email and syslog.
+func newNotificationForwardingConfigServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		for strings.Contains(path, "//") {
+			path = strings.ReplaceAll(path, "//", "/")
+		}
+		if strings.HasSuffix(path, "/") && path != "/" {
+			path = strings.TrimSuffix(path, "/")
+		}
+
+		switch {
+		case path == "/"+sdk.NotificationForwardingConfigurationsEndpoint+"/"+testNotificationForwardingConfigID && r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{
+				"data": {
+					"rule_uuid": "%s",
+					"name": "SIEM issues",
+					"description": "Forward high severity issues to the SIEM",
+					"filter": {
+						"filter": {
+							"AND": [
+								{
+									"SEARCH_FIELD": "SEVERITY",
+									"SEARCH_TYPE": "EQ",
+									"SEARCH_VALUE": "SEV_040_HIGH"
+								}
+							]
+						}
+					},
+					"applications": [],
+					"forward_source": {
+						"email": {
+							"aggregation": 10,
+							"distribution_list": ["soc@example.com"],
+							"legacy_mail_format": false,
+							"custom_mail_subject": ""
+						},
+						"syslog": {
+							"id": 42
+						}
+					},
+					"forward_type": "%s",
+					"time_zone": "UTC",
+					"slack_format": null,
+					"syslog_format": "ISSUE",
+					"mail_format": "ISSUE",
+					"created_by": "Public API - 0",
+					"created_at": 1000000000000,
+					"modified_at": 1000000000000,
+					"enabled": true
+				}
+			}`, testNotificationForwardingConfigID, enums.NotificationForwardingConfigurationTypeIssues.String()) //nolint:errcheck
+		default:
+			http.Error(w, fmt.Sprintf("[%s] Endpoint not found: %s %s", t.Name(), r.Method, path), http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestUnitNotificationForwardingConfigDataSource_Read(t *testing.T) {
+	server := newNotificationForwardingConfigServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "cortexcloud" {
+						api_url    = "%s"
+						api_key    = "test"
+						api_key_id = 123
+					}
+
+					data "cortexcloud_notification_forwarding_config" "test" {
+						id = "%s"
+					}
+				`, server.URL, testNotificationForwardingConfigID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "id", testNotificationForwardingConfigID),
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "type", "issues"),
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "name", "SIEM issues"),
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "timezone", "UTC"),
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "scope.and.#", "1"),
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "scope.and.0.search_field", "SEVERITY"),
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "scope.and.0.search_value", "SEV_040_HIGH"),
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "email_config.distribution_list.#", "1"),
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "email_config.distribution_list.0", "soc@example.com"),
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "email_config.format", "issue"),
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "email_config.grouping_timeframe", "10"),
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "syslog_config.server_id", "42"),
+					resource.TestCheckResourceAttr("data.cortexcloud_notification_forwarding_config.test", "syslog_config.format", "issue"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitNotificationForwardingConfigDataSource_NotFound(t *testing.T) {
+	server := newNotificationForwardingConfigServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "cortexcloud" {
+						api_url    = "%s"
+						api_key    = "test"
+						api_key_id = 123
+					}
+
+					data "cortexcloud_notification_forwarding_config" "test" {
+						id = "00000000-0000-0000-0000-000000000404"
+					}
+				`, server.URL),
+				ExpectError: regexp.MustCompile(`Error Reading Notification Forwarding Configuration`),
+			},
+		},
+	})
+}
@@ -5,14 +5,16 @@ package platform
 
 import (
 	"context"
-	"fmt"
 
 	platformmodel "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/platform"
+	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 
 	platformsdk "github.com/PaloAltoNetworks/cortex-cloud-go/platform"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -21,29 +23,36 @@ var (
 	_ datasource.DataSourceWithConfigure = &scopeDataSource{}
 )
 
+// NewScopeDataSource is a helper function to simplify the provider implementation.
+func NewScopeDataSource() datasource.DataSource {
+	return &scopeDataSource{}
+}
+
 // scopeDataSource is the data source implementation.
 type scopeDataSource struct {
 	client *platformsdk.Client
 }
 
-func (d *scopeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+// Configure adds the provider-configured client to the data source.
+func (d *scopeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*platformsdk.Client)
+	client, ok := req.ProviderData.(*providerModels.CortexCloudSDKClients)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *platformsdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+		util.AddUnexpectedDataSourceConfigurationTypeError(&resp.Diagnostics, "*providerModels.CortexCloudSDKClients", req.ProviderData)
 		return
 	}
 
-	d.client = client
+	d.client = client.Platform.Client(ctx, &resp.Diagnostics)
 }
 
+// Read refreshes the Terraform state with the latest data.
 func (d *scopeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
 	var data platformmodel.ScopeModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -59,6 +68,10 @@ func (d *scopeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	// Refresh the model from the remote object.
 	data.RefreshFromRemote(ctx, &resp.Diagnostics, remote)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = types.StringValue(data.EntityType.ValueString() + ":" + data.EntityID.ValueString())
 
 	// Set the state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -71,122 +84,132 @@ func (d *scopeDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 
 // Schema defines the schema for the data source.
 func (d *scopeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := scopeDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "The identifier of the scope, in the format `<entity_type>:<entity_id>`.",
+		Computed:    true,
+	}
+	attributes["entity_type"] = schema.StringAttribute{
+		Description: "The type of the entity. Possible values: `user`, `group`.",
+		Required:    true,
+	}
+	attributes["entity_id"] = schema.StringAttribute{
+		Description: "The ID of the entity. This is the email address of a user or the ID of a user group.",
+		Required:    true,
+	}
+
 	resp.Schema = schema.Schema{
 		Description: "Provides details about an existing Scope.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Computed: true,
-			},
-			"entity_type": schema.StringAttribute{
-				Description: "The type of the entity.",
-				Required:    true,
-			},
-			"entity_id": schema.StringAttribute{
-				Description: "The ID of the entity.",
-				Required:    true,
-			},
-			"assets": schema.SingleNestedAttribute{
-				Description: "The assets scope.",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"mode": schema.StringAttribute{
-						Description: "The mode of the assets scope.",
-						Computed:    true,
-					},
-					"asset_groups": schema.ListNestedAttribute{
-						Description: "The asset groups in the scope.",
-						Computed:    true,
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"asset_group_id": schema.Int64Attribute{
-									Description: "The ID of the asset group.",
-									Computed:    true,
-								},
-								"asset_group_name": schema.StringAttribute{
-									Description: "The name of the asset group.",
-									Computed:    true,
-								},
-							},
-						},
-					},
+		Attributes:  attributes,
+	}
+}
+
+// scopeDataSourceAttributes returns the computed attributes describing the
+// scope of a user or user group. They are shared by the cortexcloud_scope and
+// cortexcloud_scopes data sources.
+func scopeDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"assets": schema.SingleNestedAttribute{
+			Description: "The assets scope.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"mode": schema.StringAttribute{
+					Description: "The mode of the assets scope.",
+					Computed:    true,
 				},
-			},
-			"datasets_rows": schema.SingleNestedAttribute{
-				Description: "The datasets rows scope.",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"default_filter_mode": schema.StringAttribute{
-						Description: "The default filter mode of the datasets rows scope.",
-						Computed:    true,
-					},
-					"filters": schema.ListNestedAttribute{
-						Description: "The filters in the datasets rows scope.",
-						Computed:    true,
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"dataset": schema.StringAttribute{
-									Description: "The dataset of the filter.",
-									Computed:    true,
-								},
-								"filter": schema.StringAttribute{
-									Description: "The filter expression.",
-									Computed:    true,
-								},
+				"asset_groups": schema.ListNestedAttribute{
+					Description: "The asset groups in the scope.",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"asset_group_id": schema.Int64Attribute{
+								Description: "The ID of the asset group.",
+								Computed:    true,
+							},
+							"asset_group_name": schema.StringAttribute{
+								Description: "The name of the asset group.",
+								Computed:    true,
 							},
 						},
 					},
 				},
 			},
-			"endpoints": schema.SingleNestedAttribute{
-				Description: "The endpoints scope.",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"endpoint_groups": schema.SingleNestedAttribute{
-						Description: "The endpoint groups scope.",
-						Computed:    true,
+		},
+		"datasets_rows": schema.SingleNestedAttribute{
+			Description: "The datasets rows scope.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"default_filter_mode": schema.StringAttribute{
+					Description: "The default filter mode of the datasets rows scope.",
+					Computed:    true,
+				},
+				"filters": schema.ListNestedAttribute{
+					Description: "The filters in the datasets rows scope.",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
 						Attributes: map[string]schema.Attribute{
-							"mode": schema.StringAttribute{
-								Description: "The mode of the endpoint groups scope.",
+							"dataset": schema.StringAttribute{
+								Description: "The dataset of the filter.",
 								Computed:    true,
 							},
-							"tags": schema.ListNestedAttribute{
-								Description: "The tags in the endpoint groups scope.",
+							"filter": schema.StringAttribute{
+								Description: "The filter expression.",
 								Computed:    true,
-								NestedObject: schema.NestedAttributeObject{
-									Attributes: map[string]schema.Attribute{
-										"tag_id": schema.StringAttribute{
-											Description: "The ID of the tag.",
-											Computed:    true,
-										},
-										"tag_name": schema.StringAttribute{
-											Description: "The name of the tag.",
-											Computed:    true,
-										},
+							},
+						},
+					},
+				},
+			},
+		},
+		"endpoints": schema.SingleNestedAttribute{
+			Description: "The endpoints scope.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"endpoint_groups": schema.SingleNestedAttribute{
+					Description: "The endpoint groups scope.",
+					Computed:    true,
+					Attributes: map[string]schema.Attribute{
+						"mode": schema.StringAttribute{
+							Description: "The mode of the endpoint groups scope.",
+							Computed:    true,
+						},
+						"tags": schema.ListNestedAttribute{
+							Description: "The tags in the endpoint groups scope.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"tag_id": schema.StringAttribute{
+										Description: "The ID of the tag.",
+										Computed:    true,
+									},
+									"tag_name": schema.StringAttribute{
+										Description: "The name of the tag.",
+										Computed:    true,
 									},
 								},
 							},
 						},
 					},
-					"endpoint_tags": schema.SingleNestedAttribute{
-						Description: "The endpoint tags scope.",
-						Computed:    true,
-						Attributes: map[string]schema.Attribute{
-							"mode": schema.StringAttribute{
-								Description: "The mode of the endpoint tags scope.",
-								Computed:    true,
-							},
-							"tags": schema.ListNestedAttribute{
-								Description: "The tags in the endpoint tags scope.",
-								Computed:    true,
-								NestedObject: schema.NestedAttributeObject{
-									Attributes: map[string]schema.Attribute{
-										"tag_id": schema.StringAttribute{
-											Description: "The ID of the tag.", Computed: true,
-										},
-										"tag_name": schema.StringAttribute{
-											Description: "The name of the tag.",
-											Computed:    true,
-										},
+				},
+				"endpoint_tags": schema.SingleNestedAttribute{
+					Description: "The endpoint tags scope.",
+					Computed:    true,
+					Attributes: map[string]schema.Attribute{
+						"mode": schema.StringAttribute{
+							Description: "The mode of the endpoint tags scope.",
+							Computed:    true,
+						},
+						"tags": schema.ListNestedAttribute{
+							Description: "The tags in the endpoint tags scope.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"tag_id": schema.StringAttribute{
+										Description: "The ID of the tag.", Computed: true,
+									},
+									"tag_name": schema.StringAttribute{
+										Description: "The name of the tag.",
+										Computed:    true,
 									},
 								},
 							},
@@ -194,27 +217,27 @@ func (d *scopeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					},
 				},
 			},
-			"cases_issues": schema.SingleNestedAttribute{
-				Description: "The cases issues scope.",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"mode": schema.StringAttribute{
-						Description: "The mode of the cases issues scope.",
-						Computed:    true,
-					},
-					"tags": schema.ListNestedAttribute{
-						Description: "The tags in the cases issues scope.",
-						Computed:    true,
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"tag_id": schema.StringAttribute{
-									Description: "The ID of the tag.",
-									Computed:    true,
-								},
-								"tag_name": schema.StringAttribute{
-									Description: "The name of the tag.",
-									Computed:    true,
-								},
+		},
+		"cases_issues": schema.SingleNestedAttribute{
+			Description: "The cases issues scope.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"mode": schema.StringAttribute{
+					Description: "The mode of the cases issues scope.",
+					Computed:    true,
+				},
+				"tags": schema.ListNestedAttribute{
+					Description: "The tags in the cases issues scope.",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"tag_id": schema.StringAttribute{
+								Description: "The ID of the tag.",
+								Computed:    true,
+							},
+							"tag_name": schema.StringAttribute{
+								Description: "The name of the tag.",
+								Computed:    true,
 							},
 						},
 					},
@@ -0,0 +1,171 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	testUserScopeResponse = `{
+		"data": {
+			"assets": {
+				"mode": "scope",
+				"asset_groups": [
+					{
+						"asset_group_id": 1,
+						"asset_group_name": "Asset Group 1"
+					}
+				]
+			}
+		}
+	}`
+
+	testGroupScopeResponse = `{
+		"data": {
+			"assets": {
+				"mode": "scope",
+				"asset_groups": [
+					{
+						"asset_group_id": 2,
+						"asset_group_name": "Asset Group 2"
+					},
+					{
+						"asset_group_id": 3,
+						"asset_group_name": "Asset Group 3"
+					}
+				]
+			}
+		}
+	}`
+)
+
+// newScopeServer returns a mock server that responds to the scope, user and
+// user group endpoints with a single user ("test@example.com") and a single
+// user group ("group-1") and their scopes.
+func newScopeServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		for strings.Contains(path, "//") {
+			path = strings.ReplaceAll(path, "//", "/")
+		}
+		if strings.HasSuffix(path, "/") && path != "/" {
+			path = strings.TrimSuffix(path, "/")
+		}
+
+		switch {
+		case strings.Contains(path, "/scope") && strings.Contains(path, "test@example.com") && r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, testUserScopeResponse) //nolint:errcheck
+		case strings.Contains(path, "/scope") && strings.Contains(path, "group-1") && r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, testGroupScopeResponse) //nolint:errcheck
+		case strings.HasSuffix(path, "/rbac/get_users") && r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, `{
+				"reply": [
+					{
+						"user_email": "test@example.com",
+						"user_first_name": "Jane",
+						"user_last_name": "Doe",
+						"status": "ACTIVE"
+					}
+				]
+			}`) //nolint:errcheck
+		case strings.HasSuffix(path, "/user-group") && r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, `{
+				"data": [
+					{
+						"group_id": "group-1",
+						"group_name": "SOC Analysts",
+						"description": "Security operations",
+						"role_id": "custom-role-id"
+					}
+				]
+			}`) //nolint:errcheck
+		default:
+			http.Error(w, fmt.Sprintf("[%s] Endpoint not found: %s %s", t.Name(), r.Method, path), http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestUnitScopeDataSource_Read(t *testing.T) {
+	server := newScopeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "cortexcloud" {
+						api_url    = "%s"
+						api_key    = "test"
+						api_key_id = 123
+					}
+
+					data "cortexcloud_scope" "test" {
+						entity_type = "group"
+						entity_id   = "group-1"
+					}
+				`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cortexcloud_scope.test", "id", "group:group-1"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scope.test", "entity_type", "group"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scope.test", "entity_id", "group-1"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scope.test", "assets.mode", "scope"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scope.test", "assets.asset_groups.#", "2"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scope.test", "assets.asset_groups.0.asset_group_id", "2"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scope.test", "assets.asset_groups.1.asset_group_name", "Asset Group 3"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitScopeDataSource_NotFound(t *testing.T) {
+	server := newScopeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "cortexcloud" {
+						api_url    = "%s"
+						api_key    = "test"
+						api_key_id = 123
+					}
+
+					data "cortexcloud_scope" "test" {
+						entity_type = "group"
+						entity_id   = "missing-group"
+					}
+				`, server.URL),
+				ExpectError: regexp.MustCompile(`Error getting scope`),
+			},
+		},
+	})
+}
@@ -0,0 +1,187 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	platformmodel "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/platform"
+	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
+
+	platformsdk "github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	scopeEntityTypeUser  = "user"
+	scopeEntityTypeGroup = "group"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &scopesDataSource{}
+	_ datasource.DataSourceWithConfigure = &scopesDataSource{}
+)
+
+// NewScopesDataSource is a helper function to simplify the provider implementation.
+func NewScopesDataSource() datasource.DataSource {
+	return &scopesDataSource{}
+}
+
+// scopesDataSource is the data source implementation.
+type scopesDataSource struct {
+	client *platformsdk.Client
+}
+
+// Metadata returns the data source type name.
+func (d *scopesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scopes"
+}
+
+// Schema defines the schema for the data source.
+func (d *scopesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	scopeAttributes := scopeDataSourceAttributes()
+	scopeAttributes["id"] = schema.StringAttribute{
+		Description: "The identifier of the scope, in the format `<entity_type>:<entity_id>`.",
+		Computed:    true,
+	}
+	scopeAttributes["entity_type"] = schema.StringAttribute{
+		Description: "The type of the entity. Possible values: `user`, `group`.",
+		Computed:    true,
+	}
+	scopeAttributes["entity_id"] = schema.StringAttribute{
+		Description: "The ID of the entity. This is the email address of a user or the ID of a user group.",
+		Computed:    true,
+	}
+	scopeAttributes["entity_name"] = schema.StringAttribute{
+		Description: "The display name of the entity. This is the full name of a user or the name of a user group.",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Provides the scope of every user and user group in the tenant.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Static identifier for the data source.",
+				Computed:    true,
+			},
+			"entity_type": schema.StringAttribute{
+				Description: "Only return the scopes of entities of this type. Possible values: `user`, `group`. If not specified, the scopes of all users and user groups are returned.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(scopeEntityTypeUser, scopeEntityTypeGroup),
+				},
+			},
+			"scopes": schema.ListNestedAttribute{
+				Description: "The list of scopes.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: scopeAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *scopesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerModels.CortexCloudSDKClients)
+	if !ok {
+		util.AddUnexpectedDataSourceConfigurationTypeError(&resp.Diagnostics, "*providerModels.CortexCloudSDKClients", req.ProviderData)
+		return
+	}
+
+	d.client = client.Platform.Client(ctx, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *scopesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	tflog.Debug(ctx, "Reading scopes data source")
+
+	var config platformmodel.ScopesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entityType := config.EntityType.ValueString()
+	config.ID = types.StringValue("scopes")
+	config.Scopes = []platformmodel.ScopesDataSourceScopeModel{}
+
+	if entityType == "" || entityType == scopeEntityTypeUser {
+		users, err := d.client.ListUsers(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Listing Users", err.Error())
+			return
+		}
+
+		for _, user := range users {
+			name := strings.TrimSpace(user.FirstName + " " + user.LastName)
+			d.appendScope(ctx, &resp.Diagnostics, &config, scopeEntityTypeUser, user.Email, name)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	if entityType == "" || entityType == scopeEntityTypeGroup {
+		groups, err := d.client.ListUserGroups(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Listing User Groups", err.Error())
+			return
+		}
+
+		for _, group := range groups {
+			d.appendScope(ctx, &resp.Diagnostics, &config, scopeEntityTypeGroup, group.GroupID, group.GroupName)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// appendScope retrieves the scope of the given entity and appends it to the
+// data source model.
+func (d *scopesDataSource) appendScope(ctx context.Context, diags *diag.Diagnostics, config *platformmodel.ScopesDataSourceModel, entityType, entityID, entityName string) {
+	tflog.Trace(ctx, "Reading scope", map[string]any{
+		"entity_type": entityType,
+		"entity_id":   entityID,
+	})
+
+	remote, err := d.client.GetScope(ctx, entityType, entityID)
+	if err != nil {
+		diags.AddError(
+			"Error getting scope",
+			fmt.Sprintf("Failed to get scope for %s %q: %s", entityType, entityID, err.Error()),
+		)
+		return
+	}
+
+	var scope platformmodel.ScopesDataSourceScopeModel
+	scope.RefreshFromRemote(ctx, diags, entityType, entityID, entityName, remote)
+	if diags.HasError() {
+		return
+	}
+
+	config.Scopes = append(config.Scopes, scope)
+}
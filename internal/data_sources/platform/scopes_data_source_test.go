@@ -0,0 +1,59 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitScopesDataSource_Read(t *testing.T) {
+	server := newScopeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "cortexcloud" {
+						api_url    = "%s"
+						api_key    = "test"
+						api_key_id = 123
+					}
+
+					data "cortexcloud_scopes" "all" {}
+
+					data "cortexcloud_scopes" "groups" {
+						entity_type = "group"
+					}
+				`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.all", "id", "scopes"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.all", "scopes.#", "2"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.all", "scopes.0.id", "user:test@example.com"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.all", "scopes.0.entity_type", "user"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.all", "scopes.0.entity_id", "test@example.com"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.all", "scopes.0.entity_name", "Jane Doe"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.all", "scopes.0.assets.asset_groups.#", "1"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.all", "scopes.0.assets.asset_groups.0.asset_group_id", "1"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.all", "scopes.1.id", "group:group-1"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.all", "scopes.1.entity_type", "group"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.all", "scopes.1.entity_name", "SOC Analysts"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.all", "scopes.1.assets.asset_groups.#", "2"),
+
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.groups", "scopes.#", "1"),
+					resource.TestCheckResourceAttr("data.cortexcloud_scopes.groups", "scopes.0.entity_id", "group-1"),
+				),
+			},
+		},
+	})
+}
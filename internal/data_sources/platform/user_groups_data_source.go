@@ -0,0 +1,185 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/platform"
+	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &userGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &userGroupsDataSource{}
+)
+
+// NewUserGroupsDataSource is a helper function to simplify the provider implementation.
+func NewUserGroupsDataSource() datasource.DataSource {
+	return &userGroupsDataSource{}
+}
+
+// userGroupsDataSource implements data "cortexcloud_user_groups"
+type userGroupsDataSource struct {
+	client *platform.Client
+}
+
+// Metadata returns the data source type name.
+func (d *userGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_groups"
+}
+
+// Schema defines the schema for the data source.
+func (d *userGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a filtered list of User Groups. All configured filters must match for a user group to be returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Static identifier for the data source.",
+				Computed:    true,
+			},
+			"role_id": schema.StringAttribute{
+				Description: "Only return user groups assigned the role with this ID.",
+				Optional:    true,
+			},
+			"group_type": schema.StringAttribute{
+				Description: "Only return user groups of this type. Possible values: `custom`, `ad_type`. The comparison is case-insensitive.",
+				Optional:    true,
+			},
+			"user_email": schema.StringAttribute{
+				Description: "Only return user groups that the user with this email address is an effective member of. The comparison is case-insensitive.",
+				Optional:    true,
+			},
+			"name_pattern": schema.StringAttribute{
+				Description: "Only return user groups whose name matches this regular expression (e.g. `^SOC `).",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.StringIsValidRegularExpression(),
+				},
+			},
+			"user_groups": schema.ListNestedAttribute{
+				Description: "The list of user groups matching the configured filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the user group.",
+							Computed:    true,
+						},
+						"group_name": schema.StringAttribute{
+							Description: "The unique name of the user group.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A brief description of the user group's purpose.",
+							Computed:    true,
+						},
+						"role_id": schema.StringAttribute{
+							Description: "The unique identifier of the role assigned to this group.",
+							Computed:    true,
+						},
+						"pretty_role_name": schema.StringAttribute{
+							Description: "The display name of the role assigned to this group.",
+							Computed:    true,
+						},
+						"created_by": schema.StringAttribute{
+							Description: "The user or system that created the user group.",
+							Computed:    true,
+						},
+						"created_ts": schema.Int64Attribute{
+							Description: "Unix timestamp (milliseconds) of when the user group was created.",
+							Computed:    true,
+						},
+						"updated_ts": schema.Int64Attribute{
+							Description: "Unix timestamp (milliseconds) of when the user group was last updated.",
+							Computed:    true,
+						},
+						"all_users": schema.SetAttribute{
+							Description: "A list of users with effective membership for this group, including users added via SSO/JIT authentication.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"group_type": schema.StringAttribute{
+							Description: "The type of the user group. Possible values: `custom` (created directly in the UI), `ad_type` (imported and synchronized from Azure Active Directory).",
+							Computed:    true,
+						},
+						"nested_groups": schema.SetNestedAttribute{
+							Description: "The list of direct child groups nested within this user group.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"group_id": schema.StringAttribute{
+										Description: "The unique identifier of the nested group.",
+										Computed:    true,
+									},
+									"group_name": schema.StringAttribute{
+										Description: "The display name of the nested group.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"idp_groups": schema.SetAttribute{
+							Description: "The identity provider (IdP) group names associated with this group.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *userGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerModels.CortexCloudSDKClients)
+	if !ok {
+		util.AddUnexpectedDataSourceConfigurationTypeError(&resp.Diagnostics, "*providerModels.CortexCloudSDKClients", req.ProviderData)
+		return
+	}
+
+	d.client = clients.Platform.Client(ctx, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *userGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	tflog.Debug(ctx, "Reading user groups data source")
+
+	var config models.UserGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := d.client.ListUserGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing User Groups", err.Error())
+		return
+	}
+
+	config.RefreshFromRemote(ctx, &resp.Diagnostics, groups)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,183 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform
+
+import (
+	"context"
+
+	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/platform"
+	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/validators"
+
+	platformsdk "github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// NewUsersDataSource is a helper function to simplify the provider implementation.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	client *platformsdk.Client
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a filtered list of users. All configured filters must match for a user to be returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Static identifier for the data source.",
+				Computed:    true,
+			},
+			"role_name": schema.StringAttribute{
+				Description: "Only return users assigned the role with this name. The comparison is case-insensitive.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only return users with this status (e.g. `ACTIVE`). The comparison is case-insensitive.",
+				Optional:    true,
+			},
+			"user_type": schema.StringAttribute{
+				Description: "Only return users of this user type. The comparison is case-insensitive.",
+				Optional:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Only return users that are a member of the user group with this ID.",
+				Optional:    true,
+			},
+			"group_name": schema.StringAttribute{
+				Description: "Only return users that are a member of the user group with this name.",
+				Optional:    true,
+			},
+			"email_pattern": schema.StringAttribute{
+				Description: "Only return users whose email address matches this regular expression (e.g. `@example\\.com$`).",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.StringIsValidRegularExpression(),
+				},
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The list of users matching the configured filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_email": schema.StringAttribute{
+							Description: "The email address of the user.",
+							Computed:    true,
+						},
+						"user_first_name": schema.StringAttribute{
+							Description: "The first name of the user.",
+							Computed:    true,
+						},
+						"user_last_name": schema.StringAttribute{
+							Description: "The last name of the user.",
+							Computed:    true,
+						},
+						"phone_number": schema.StringAttribute{
+							Description: "The phone number of the user.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the user.",
+							Computed:    true,
+						},
+						"role_name": schema.StringAttribute{
+							Description: "The role name of the user.",
+							Computed:    true,
+						},
+						"last_logged_in": schema.Int64Attribute{
+							Description: "The last logged in timestamp of the user.",
+							Computed:    true,
+						},
+						"hidden": schema.BoolAttribute{
+							Description: "The hidden status of the user.",
+							Computed:    true,
+						},
+						"user_type": schema.StringAttribute{
+							Description: "The user type of the user.",
+							Computed:    true,
+						},
+						"groups": schema.ListNestedAttribute{
+							Description: "The groups of the user.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"group_id": schema.StringAttribute{
+										Description: "The ID of the nested group.",
+										Computed:    true,
+									},
+									"group_name": schema.StringAttribute{
+										Description: "The name of the nested group.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *usersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerModels.CortexCloudSDKClients)
+	if !ok {
+		util.AddUnexpectedDataSourceConfigurationTypeError(&resp.Diagnostics, "*providerModels.CortexCloudSDKClients", req.ProviderData)
+		return
+	}
+
+	d.client = client.Platform.Client(ctx, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	tflog.Debug(ctx, "Reading users data source")
+
+	var config models.UsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.ListUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Users", err.Error())
+		return
+	}
+
+	config.RefreshFromRemote(ctx, &resp.Diagnostics, users)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/PaloAltoNetworks/cortex-cloud-go/vulnerability"
 	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	sharedModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/shared"
 	vulnerabilityModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/vulnerability"
 	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 
@@ -82,12 +83,24 @@ func (d *policiesDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 						},
 						"match_criteria": schema.StringAttribute{
 							Description: "The match criteria as a JSON-encoded string.",
+							CustomType:  util.NormalizedJSONType{},
 							Computed:    true,
 						},
 						"exclusion_criteria": schema.StringAttribute{
 							Description: "The exclusion criteria as a JSON-encoded string.",
+							CustomType:  util.NormalizedJSONType{},
 							Computed:    true,
 						},
+						"match_criteria_filter": schema.SingleNestedAttribute{
+							Description: "The match criteria for the vulnerability policy.",
+							Computed:    true,
+							Attributes:  sharedModels.RootFilterComputedDataSourceAttributes,
+						},
+						"exclusion_criteria_filter": schema.SingleNestedAttribute{
+							Description: "The exclusion criteria for the vulnerability policy.",
+							Computed:    true,
+							Attributes:  sharedModels.RootFilterComputedDataSourceAttributes,
+						},
 						"action": schema.ListNestedAttribute{
 							Description: "The actions to take when the policy matches.",
 							Computed:    true,
@@ -198,12 +211,13 @@ func (d *policiesDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	d.client = client.Vulnerability
+	d.client = client.Vulnerability.Client(ctx, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *policiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	ctx = tflog.SetField(ctx, "data_source_type", "VulnerabilityPoliciesDataSource")
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/PaloAltoNetworks/cortex-cloud-go/vulnerability"
 	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	sharedModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/shared"
 	vulnerabilityModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/vulnerability"
 	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 
@@ -65,12 +66,24 @@ func (d *policyDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			},
 			"match_criteria": schema.StringAttribute{
 				Description: "The match criteria for the vulnerability policy as a JSON-encoded string.",
+				CustomType:  util.NormalizedJSONType{},
 				Computed:    true,
 			},
 			"exclusion_criteria": schema.StringAttribute{
 				Description: "The exclusion criteria for the vulnerability policy as a JSON-encoded string.",
+				CustomType:  util.NormalizedJSONType{},
 				Computed:    true,
 			},
+			"match_criteria_filter": schema.SingleNestedAttribute{
+				Description: "The match criteria for the vulnerability policy.",
+				Computed:    true,
+				Attributes:  sharedModels.RootFilterComputedDataSourceAttributes,
+			},
+			"exclusion_criteria_filter": schema.SingleNestedAttribute{
+				Description: "The exclusion criteria for the vulnerability policy.",
+				Computed:    true,
+				Attributes:  sharedModels.RootFilterComputedDataSourceAttributes,
+			},
 			"action": schema.ListNestedAttribute{
 				Description: "The actions to take when the policy matches.",
 				Computed:    true,
@@ -151,12 +164,13 @@ func (d *policyDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	d.client = client.Vulnerability
+	d.client = client.Vulnerability.Client(ctx, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *policyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	ctx = tflog.SetField(ctx, "data_source_type", "VulnerabilityPolicyDataSource")
 
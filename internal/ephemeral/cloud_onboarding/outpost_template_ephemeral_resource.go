@@ -107,11 +107,12 @@ func (r *OutpostTemplateEphemeralResource) Configure(ctx context.Context, req ep
 		return
 	}
 
-	r.client = client.CloudOnboarding
+	r.client = client.CloudOnboarding.Client(ctx, &resp.Diagnostics)
 }
 
 func (r *OutpostTemplateEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	ctx = tflog.SetField(ctx, "ephemeral_resource_type", "outpost_template")
 	ctx = tflog.SetField(ctx, "ephemeral_resource_operation", "Open")
@@ -0,0 +1,318 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package export
+
+import (
+	"context"
+	"strconv"
+
+	appsecTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/appsec"
+	cloudsecTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/cloudsec"
+	complianceTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/compliance"
+	platformTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/platform"
+	vulnerabilityTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/vulnerability"
+
+	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+)
+
+// pageSize is the number of records requested per page from the paginated
+// list endpoints.
+const pageSize = 100
+
+// exportedObject is an object in the tenant that can be exported.
+type exportedObject struct {
+	// ID is the value passed to the resource's ImportState method.
+	ID string
+	// Name is used to derive the resource label in the generated
+	// configuration.
+	Name string
+}
+
+// exportableType describes a resource type supported by the export command
+// and how the objects of that type are listed from the tenant.
+type exportableType struct {
+	// Name is the resource type name, including the provider prefix.
+	Name string
+	// List returns the user-managed objects of this type. Objects that are
+	// provided by Cortex Cloud out of the box are omitted, as they cannot
+	// be managed by Terraform.
+	//
+	// List is nil for resource types whose objects cannot be listed using
+	// the SDK. Objects of these types are only exported when their IDs are
+	// given explicitly.
+	List func(ctx context.Context, clients *providerModels.CortexCloudSDKClients) ([]exportedObject, error)
+}
+
+// exportableTypes returns the resource types supported by the export
+// command, in the order they are written to the generated configuration.
+func exportableTypes() []exportableType {
+	return []exportableType{
+		{Name: "cortexcloud_asset_group", List: listAssetGroups},
+		{Name: "cortexcloud_iam_role", List: listIamRoles},
+		{Name: "cortexcloud_user_group", List: listUserGroups},
+		{Name: "cortexcloud_cloudsec_rule", List: listCloudSecRules},
+		// The SDK does not provide a search operation for CloudSec policies
+		{Name: "cortexcloud_cloudsec_policy"},
+		{Name: "cortexcloud_compliance_control", List: listComplianceControls},
+		{Name: "cortexcloud_compliance_standard", List: listComplianceStandards},
+		{Name: "cortexcloud_compliance_assessment_profile", List: listComplianceAssessmentProfiles},
+		{Name: "cortexcloud_vulnerability_policy", List: listVulnerabilityPolicies},
+		{Name: "cortexcloud_appsec_policy", List: listAppSecPolicies},
+		{Name: "cortexcloud_cwp_policy", List: listCWPPolicies},
+		// The SDK does not provide a list operation for notification
+		// forwarding configurations
+		{Name: "cortexcloud_notification_forwarding_config_issues"},
+		{Name: "cortexcloud_notification_forwarding_config_cases"},
+		{Name: "cortexcloud_notification_forwarding_config_agent_audit_logs"},
+		{Name: "cortexcloud_notification_forwarding_config_mgmt_audit_logs"},
+	}
+}
+
+func listAssetGroups(ctx context.Context, clients *providerModels.CortexCloudSDKClients) ([]exportedObject, error) {
+	client, err := clients.Platform.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assetGroups, err := client.ListAssetGroups(ctx, platformTypes.ListAssetGroupsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(assetGroups))
+	for _, assetGroup := range assetGroups {
+		objects = append(objects, exportedObject{ID: strconv.Itoa(assetGroup.ID), Name: assetGroup.Name})
+	}
+
+	return objects, nil
+}
+
+func listIamRoles(ctx context.Context, clients *providerModels.CortexCloudSDKClients) ([]exportedObject, error) {
+	client, err := clients.Platform.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := client.ListAllRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []exportedObject{}
+	for _, role := range roles.Data {
+		if !role.IsCustom {
+			continue
+		}
+		objects = append(objects, exportedObject{ID: role.RoleID, Name: role.PrettyName})
+	}
+
+	return objects, nil
+}
+
+func listUserGroups(ctx context.Context, clients *providerModels.CortexCloudSDKClients) ([]exportedObject, error) {
+	client, err := clients.Platform.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := client.ListUserGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(groups))
+	for _, group := range groups {
+		objects = append(objects, exportedObject{ID: group.GroupID, Name: group.GroupName})
+	}
+
+	return objects, nil
+}
+
+func listCloudSecRules(ctx context.Context, clients *providerModels.CortexCloudSDKClients) ([]exportedObject, error) {
+	client, err := clients.CloudSec.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []exportedObject{}
+	for from := 0; ; from += pageSize {
+		resp, err := client.Search(ctx, cloudsecTypes.SearchRulesRequest{
+			SearchFrom: int32(from),
+			SearchTo:   int32(from + pageSize),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range resp.Data {
+			if rule.SystemDefault {
+				continue
+			}
+			objects = append(objects, exportedObject{ID: rule.ID, Name: rule.Name})
+		}
+
+		if len(resp.Data) < pageSize {
+			return objects, nil
+		}
+	}
+}
+
+func listComplianceControls(ctx context.Context, clients *providerModels.CortexCloudSDKClients) ([]exportedObject, error) {
+	client, err := clients.Compliance.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []exportedObject{}
+	for from := 0; ; from += pageSize {
+		resp, err := client.ListControls(ctx, complianceTypes.ListControlsRequest{
+			SearchFrom: from,
+			SearchTo:   from + pageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, control := range resp.Controls {
+			if !control.IsCustom {
+				continue
+			}
+			objects = append(objects, exportedObject{ID: control.ID, Name: control.Name})
+		}
+
+		if len(resp.Controls) < pageSize {
+			return objects, nil
+		}
+	}
+}
+
+func listComplianceStandards(ctx context.Context, clients *providerModels.CortexCloudSDKClients) ([]exportedObject, error) {
+	client, err := clients.Compliance.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []exportedObject{}
+	for from := 0; ; from += pageSize {
+		resp, err := client.ListStandards(ctx, complianceTypes.ListStandardsRequest{
+			Pagination: &complianceTypes.Pagination{
+				SearchFrom: from,
+				SearchTo:   from + pageSize,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, standard := range resp.Standards {
+			if !standard.IsCustom {
+				continue
+			}
+			objects = append(objects, exportedObject{ID: standard.ID, Name: standard.Name})
+		}
+
+		if len(resp.Standards) < pageSize {
+			return objects, nil
+		}
+	}
+}
+
+func listComplianceAssessmentProfiles(ctx context.Context, clients *providerModels.CortexCloudSDKClients) ([]exportedObject, error) {
+	client, err := clients.Compliance.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []exportedObject{}
+	for from := 0; ; from += pageSize {
+		resp, err := client.ListAssessmentProfiles(ctx, complianceTypes.ListAssessmentProfilesRequest{
+			Pagination: &complianceTypes.Pagination{
+				SearchFrom: from,
+				SearchTo:   from + pageSize,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, profile := range resp.AssessmentProfiles {
+			objects = append(objects, exportedObject{ID: profile.ID, Name: profile.Name})
+		}
+
+		if len(resp.AssessmentProfiles) < pageSize {
+			return objects, nil
+		}
+	}
+}
+
+func listVulnerabilityPolicies(ctx context.Context, clients *providerModels.CortexCloudSDKClients) ([]exportedObject, error) {
+	client, err := clients.Vulnerability.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []exportedObject{}
+	for from := 0; ; from += pageSize {
+		resp, err := client.ListPolicies(ctx, vulnerabilityTypes.ListVulnerabilityManagementPoliciesRequest{
+			FilterData: vulnerabilityTypes.VulnerabilityManagementFilterData{
+				Paging: vulnerabilityTypes.VulnerabilityManagementPaging{
+					From: from,
+					To:   from + pageSize,
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, policy := range resp.DATA {
+			objects = append(objects, exportedObject{ID: policy.ID, Name: policy.NAME})
+		}
+
+		if len(resp.DATA) < pageSize {
+			return objects, nil
+		}
+	}
+}
+
+func listAppSecPolicies(ctx context.Context, clients *providerModels.CortexCloudSDKClients) ([]exportedObject, error) {
+	client, err := clients.AppSec.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := client.ListPolicies(ctx, appsecTypes.ListPoliciesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []exportedObject{}
+	for _, policy := range policies {
+		if !policy.IsCustom {
+			continue
+		}
+		objects = append(objects, exportedObject{ID: policy.ID, Name: policy.Name})
+	}
+
+	return objects, nil
+}
+
+func listCWPPolicies(ctx context.Context, clients *providerModels.CortexCloudSDKClients) ([]exportedObject, error) {
+	client, err := clients.CWP.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := client.ListPolicies(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(policies))
+	for _, policy := range policies {
+		objects = append(objects, exportedObject{ID: policy.ID, Name: policy.Name})
+	}
+
+	return objects, nil
+}
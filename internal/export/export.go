@@ -0,0 +1,292 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package export implements the export command, which generates Terraform
+// configuration and import blocks for the objects that already exist in a
+// Cortex Cloud tenant.
+package export
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Run parses the arguments of the export command, exports the configured
+// resource types from the tenant and writes the generated configuration to
+// the output file or, if no output file is specified, to stdout.
+func Run(ctx context.Context, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: terraform-provider-cortexcloud export [options]\n\n"+
+			"Generates Terraform configuration and import blocks for the objects in a Cortex Cloud tenant.\n"+
+			"Credentials are read from the provider configuration file and environment variables.\n\nOptions:\n")
+		flags.PrintDefaults()
+	}
+	configFile := flags.String("config-file", "", "Path to a provider configuration file.")
+	profile := flags.String("profile", "", "Name of the profile in the provider configuration file to use.")
+	output := flags.String("output", "", "Path of the file to write the generated configuration to. Defaults to stdout.")
+	resourceTypes := flags.String("types", "", "Comma-separated list of resource types to export. Defaults to all supported resource types.")
+	objectIDs := flags.String("ids", "", "Comma-separated list of resource_type=id pairs identifying additional objects to export. Required for resource types that cannot be listed, such as cortexcloud_cloudsec_policy and the cortexcloud_notification_forwarding_config_* resource types.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var diags diag.Diagnostics
+	config := &providerModels.CortexCloudProviderModel{}
+	if *configFile != "" {
+		config.ConfigFile = types.StringValue(*configFile)
+	}
+	if *profile != "" {
+		config.Profile = types.StringValue(*profile)
+	}
+	config.ParseConfigFile(ctx, &diags)
+	if !diags.HasError() {
+		config.ParseEnvVars(ctx, &diags)
+	}
+	if !diags.HasError() {
+		config.ParseCredentialProcess(ctx, &diags)
+	}
+	if !diags.HasError() {
+		config.Validate(ctx, &diags)
+	}
+	if diags.HasError() {
+		return diagnosticsError(diags)
+	}
+
+	clients, err := provider.NewSDKClients(config)
+	if err != nil {
+		return err
+	}
+
+	var filter []string
+	if *resourceTypes != "" {
+		for _, resourceType := range strings.Split(*resourceTypes, ",") {
+			filter = append(filter, strings.TrimSpace(resourceType))
+		}
+	}
+
+	ids := map[string][]string{}
+	if *objectIDs != "" {
+		for _, pair := range strings.Split(*objectIDs, ",") {
+			resourceType, id, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if !ok || resourceType == "" || id == "" {
+				return fmt.Errorf("invalid value %q for the -ids flag: expected resource_type=id", pair)
+			}
+			ids[resourceType] = append(ids[resourceType], id)
+		}
+	}
+
+	w := stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+
+	return Export(ctx, clients, w, filter, ids)
+}
+
+// Export writes a resource block and an import block for each object of
+// the given resource types found in the tenant, and for each object whose
+// ID is given in ids, keyed by resource type. If no resource types are
+// given, all supported resource types are exported.
+//
+// Resource types whose objects cannot be listed using the SDK are only
+// exported for the IDs given in ids. Requesting one of these resource types
+// without any IDs is an error.
+//
+// The provider's own resource implementations are used to import and read
+// each object, so the generated configuration matches what the provider
+// would store in state after running `terraform import`.
+func Export(ctx context.Context, clients *providerModels.CortexCloudSDKClients, w io.Writer, resourceTypes []string, ids map[string][]string) error {
+	factories := resourceFactories(ctx)
+
+	supported := map[string]bool{}
+	for _, t := range exportableTypes() {
+		supported[t.Name] = true
+	}
+
+	requested := map[string]bool{}
+	for _, resourceType := range resourceTypes {
+		if !supported[resourceType] {
+			return fmt.Errorf("resource type %q is not supported by the export command", resourceType)
+		}
+		requested[resourceType] = true
+	}
+	for resourceType := range ids {
+		if !supported[resourceType] {
+			return fmt.Errorf("resource type %q is not supported by the export command", resourceType)
+		}
+	}
+
+	for _, t := range exportableTypes() {
+		if len(resourceTypes) > 0 && !requested[t.Name] && len(ids[t.Name]) == 0 {
+			continue
+		}
+
+		newResource, ok := factories[t.Name]
+		if !ok {
+			return fmt.Errorf("resource type %q is not registered by the provider", t.Name)
+		}
+
+		objects := []exportedObject{}
+		for _, id := range ids[t.Name] {
+			objects = append(objects, exportedObject{ID: id})
+		}
+
+		if t.List == nil {
+			if len(objects) == 0 {
+				if requested[t.Name] {
+					return fmt.Errorf("objects of resource type %q cannot be listed and must be selected by ID using the -ids flag", t.Name)
+				}
+				tflog.Debug(ctx, fmt.Sprintf("Skipping %s, as its objects cannot be listed and no IDs were given", t.Name))
+				continue
+			}
+		} else if len(resourceTypes) == 0 || requested[t.Name] {
+			tflog.Debug(ctx, fmt.Sprintf("Listing %s objects", t.Name))
+			listed, err := t.List(ctx, clients)
+			if err != nil {
+				return fmt.Errorf("error listing %s objects: %w", t.Name, err)
+			}
+			for _, object := range listed {
+				if !slices.Contains(ids[t.Name], object.ID) {
+					objects = append(objects, object)
+				}
+			}
+		}
+
+		usedLabels := map[string]bool{}
+		for _, object := range objects {
+			block, err := exportObject(ctx, clients, newResource, t.Name, object, usedLabels)
+			if err != nil {
+				// Do not abort the export if a single object cannot be
+				// read, but leave a trace of it in the output.
+				tflog.Warn(ctx, fmt.Sprintf("Unable to export %s %q: %s", t.Name, object.ID, err.Error()))
+				block = fmt.Sprintf("# Unable to export %s with ID %s: %s\n", t.Name, quoteString(object.ID), strings.ReplaceAll(err.Error(), "\n", " "))
+			}
+			if block == "" {
+				continue
+			}
+
+			if _, err := fmt.Fprintf(w, "%s\n", block); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// exportObject imports the given object using the given resource and
+// renders its resource and import blocks. If the name of the object is not
+// known, the resource label is derived from its name attribute once it has
+// been read. An empty string is returned if the object no longer exists.
+func exportObject(ctx context.Context, clients *providerModels.CortexCloudSDKClients, newResource func() resource.Resource, resourceType string, object exportedObject, usedLabels map[string]bool) (string, error) {
+	id := object.ID
+
+	r := newResource()
+
+	if configurable, ok := r.(resource.ResourceWithConfigure); ok {
+		configureResp := resource.ConfigureResponse{}
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: clients}, &configureResp)
+		if configureResp.Diagnostics.HasError() {
+			return "", diagnosticsError(configureResp.Diagnostics)
+		}
+	}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return "", diagnosticsError(schemaResp.Diagnostics)
+	}
+	s := schemaResp.Schema
+
+	importer, ok := r.(resource.ResourceWithImportState)
+	if !ok {
+		return "", fmt.Errorf("resource type %q does not support import", resourceType)
+	}
+
+	importResp := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+	}
+	importer.ImportState(ctx, resource.ImportStateRequest{ID: id}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		return "", diagnosticsError(importResp.Diagnostics)
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		return "", diagnosticsError(readResp.Diagnostics)
+	}
+	if readResp.State.Raw.IsNull() {
+		tflog.Debug(ctx, fmt.Sprintf("%s %q no longer exists, skipping", resourceType, id))
+		return "", nil
+	}
+
+	name := object.Name
+	if name == "" {
+		var stateName types.String
+		if diags := readResp.State.GetAttribute(ctx, path.Root("name"), &stateName); !diags.HasError() {
+			name = stateName.ValueString()
+		}
+	}
+	label := resourceLabel(name, usedLabels)
+
+	block, err := renderResourceBlock(resourceType, label, s, readResp.State.Raw)
+	if err != nil {
+		return "", err
+	}
+
+	return block + "\n" + renderImportBlock(resourceType, label, id), nil
+}
+
+// resourceFactories returns the resource constructors registered by the
+// provider, keyed by resource type name.
+func resourceFactories(ctx context.Context) map[string]func() resource.Resource {
+	p := provider.New("export")()
+
+	factories := map[string]func() resource.Resource{}
+	for _, newResource := range p.Resources(ctx) {
+		metadataResp := resource.MetadataResponse{}
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "cortexcloud"}, &metadataResp)
+		factories[metadataResp.TypeName] = newResource
+	}
+
+	return factories
+}
+
+// diagnosticsError converts the error diagnostics in the given diagnostics
+// into an error.
+func diagnosticsError(diags diag.Diagnostics) error {
+	errs := []error{}
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,251 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/cortex-cloud-go/enums"
+	sdk "github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/export"
+	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClients(t *testing.T, apiURL string) *providerModels.CortexCloudSDKClients {
+	t.Helper()
+
+	clients, err := provider.NewSDKClients(&providerModels.CortexCloudProviderModel{
+		APIURL:   types.StringValue(apiURL),
+		APIKey:   types.StringValue("test"),
+		APIKeyID: types.Int32Value(123),
+	})
+	require.NoError(t, err)
+
+	return clients
+}
+
+func TestUnitExport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		for strings.Contains(path, "//") {
+			path = strings.ReplaceAll(path, "//", "/")
+		}
+		if strings.HasSuffix(path, "/") && path != "/" {
+			path = strings.TrimSuffix(path, "/")
+		}
+
+		switch {
+		case path == "/platform/iam/v1/role" && r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, `{
+				"data": [
+					{
+						"role_id": "custom-role-id",
+						"pretty_name": "Custom Role",
+						"description": "Uses ${template} syntax",
+						"is_custom": true,
+						"created_by": "test-user",
+						"created_ts": 1678886400000,
+						"updated_ts": 1678886400000
+					},
+					{
+						"role_id": "builtin-role-id",
+						"pretty_name": "Instance Administrator",
+						"description": "Built-in role",
+						"is_custom": false,
+						"created_by": "system",
+						"created_ts": 1678886400000,
+						"updated_ts": 1678886400000
+					}
+				],
+				"metadata": { "total_count": 2 }
+			}`) //nolint:errcheck
+
+		case strings.HasSuffix(path, "/user-group") && r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, `{
+				"data": [
+					{
+						"group_id": "group-1",
+						"group_name": "SOC Analysts",
+						"description": "First group",
+						"role_id": "custom-role-id"
+					},
+					{
+						"group_id": "group-2",
+						"group_name": "SOC Analysts",
+						"description": "Second group with the same name",
+						"role_id": "custom-role-id"
+					}
+				]
+			}`) //nolint:errcheck
+
+		case strings.HasSuffix(path, "/rbac/get_users") && r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, `{"reply": []}`) //nolint:errcheck
+
+		default:
+			http.Error(w, "not found: "+r.URL.Path, http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	clients := newTestClients(t, server.URL)
+
+	var out bytes.Buffer
+	err := export.Export(context.Background(), clients, &out, []string{"cortexcloud_iam_role", "cortexcloud_user_group"}, nil)
+	require.NoError(t, err)
+
+	generated := out.String()
+
+	// Custom roles are exported with an import block
+	assert.Contains(t, generated, `resource "cortexcloud_iam_role" "custom_role" {`)
+	assert.Contains(t, generated, `"Custom Role"`)
+	assert.Contains(t, generated, "import {\n  to = cortexcloud_iam_role.custom_role\n  id = \"custom-role-id\"\n}")
+
+	// Template sequences in string values are escaped
+	assert.Contains(t, generated, `"Uses $${template} syntax"`)
+
+	// Computed attributes are not written to the configuration
+	assert.NotContains(t, generated, "created_by")
+	assert.NotContains(t, generated, "is_custom")
+
+	// Built-in roles are not exported
+	assert.NotContains(t, generated, "builtin-role-id")
+
+	// Duplicate names are given unique labels
+	assert.Contains(t, generated, `resource "cortexcloud_user_group" "soc_analysts" {`)
+	assert.Contains(t, generated, `resource "cortexcloud_user_group" "soc_analysts_2" {`)
+	assert.Contains(t, generated, "import {\n  to = cortexcloud_user_group.soc_analysts_2\n  id = \"group-2\"\n}")
+}
+
+func TestUnitExport_UnsupportedResourceType(t *testing.T) {
+	clients := newTestClients(t, "https://api-test.xdr.us.paloaltonetworks.com")
+
+	var out bytes.Buffer
+	err := export.Export(context.Background(), clients, &out, []string{"cortexcloud_unknown"}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cortexcloud_unknown")
+	assert.Empty(t, out.String())
+}
+
+func TestUnitExport_ByID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		for strings.Contains(path, "//") {
+			path = strings.ReplaceAll(path, "//", "/")
+		}
+		if strings.HasSuffix(path, "/") && path != "/" {
+			path = strings.TrimSuffix(path, "/")
+		}
+
+		switch {
+		case path == "/public_api/v1/policy/policy-123" && r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, `{
+				"data": {
+					"id": "policy-123",
+					"name": "Production Policy",
+					"description": "Policy matching all rules",
+					"labels": ["production"],
+					"rule_matching_type": "ALL_RULES",
+					"asset_matching_type": "ALL_ASSETS",
+					"enabled": true,
+					"mode": "CUSTOM",
+					"creation_time": 1678886400000,
+					"created_by": "test-user",
+					"modification_time": 1678886400000,
+					"modified_by": "test-user"
+				}
+			}`) //nolint:errcheck
+
+		case path == "/"+sdk.NotificationForwardingConfigurationsEndpoint+"/00000000-0000-0000-0000-000000000001" && r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{
+				"data": {
+					"rule_uuid": "00000000-0000-0000-0000-000000000001",
+					"name": "Audit Log Forwarding",
+					"description": "Forwards agent audit logs",
+					"filter": {
+						"filter": {
+							"AND": [
+								{
+									"SEARCH_FIELD": "SEVERITY",
+									"SEARCH_TYPE": "EQ",
+									"SEARCH_VALUE": "SEV_010_INFO"
+								}
+							]
+						}
+					},
+					"applications": [],
+					"forward_source": {
+						"email": {
+							"aggregation": 0,
+							"distribution_list": ["soc@test.com"],
+							"legacy_mail_format": false,
+							"custom_mail_subject": "Audit"
+						}
+					},
+					"forward_type": "%s",
+					"time_zone": "UTC",
+					"slack_format": null,
+					"syslog_format": null,
+					"mail_format": null,
+					"created_by": "test-user",
+					"created_at": 1678886400000,
+					"modified_at": 1678886400000,
+					"enabled": true
+				}
+			}`, enums.NotificationForwardingConfigurationTypeAgentAuditLogs.String()) //nolint:errcheck
+
+		default:
+			http.Error(w, "not found: "+r.URL.Path, http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	clients := newTestClients(t, server.URL)
+
+	var out bytes.Buffer
+	err := export.Export(context.Background(), clients, &out, nil, map[string][]string{
+		"cortexcloud_cloudsec_policy":                                 {"policy-123"},
+		"cortexcloud_notification_forwarding_config_agent_audit_logs": {"00000000-0000-0000-0000-000000000001"},
+	})
+	require.NoError(t, err)
+
+	generated := out.String()
+
+	// Objects are labelled using the name read from the tenant
+	assert.Contains(t, generated, `resource "cortexcloud_cloudsec_policy" "production_policy" {`)
+	assert.Contains(t, generated, `"Production Policy"`)
+	assert.Contains(t, generated, "import {\n  to = cortexcloud_cloudsec_policy.production_policy\n  id = \"policy-123\"\n}")
+
+	assert.Contains(t, generated, `resource "cortexcloud_notification_forwarding_config_agent_audit_logs" "audit_log_forwarding" {`)
+	assert.Contains(t, generated, `"Audit Log Forwarding"`)
+	assert.Contains(t, generated, "import {\n  to = cortexcloud_notification_forwarding_config_agent_audit_logs.audit_log_forwarding\n  id = \"00000000-0000-0000-0000-000000000001\"\n}")
+
+	// Both objects were read successfully
+	assert.NotContains(t, generated, "# Unable to export")
+}
+
+func TestUnitExport_UnlistableResourceTypeWithoutIDs(t *testing.T) {
+	clients := newTestClients(t, "https://api-test.xdr.us.paloaltonetworks.com")
+
+	var out bytes.Buffer
+	err := export.Export(context.Background(), clients, &out, []string{"cortexcloud_cloudsec_policy"}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "-ids")
+	assert.Empty(t, out.String())
+}
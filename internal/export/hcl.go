@@ -0,0 +1,441 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package export
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// indentUnit is the indentation used for each nesting level, matching the
+// output of `terraform fmt`.
+const indentUnit = "  "
+
+// renderResourceBlock renders a resource block for the given resource type
+// and label. Only the attributes that can be set in configuration are
+// rendered; computed-only, sensitive and deprecated attributes as well as
+// null values are omitted. Required attributes whose value cannot be read
+// back from the tenant are rendered as TODO comments, so that they are
+// easy to find when filling them in.
+func renderResourceBlock(resourceType string, label string, s schema.Schema, state tftypes.Value) (string, error) {
+	body, err := renderBody(1, s.Attributes, s.Blocks, state)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("resource %s %s {\n%s}\n", quoteString(resourceType), quoteString(label), body), nil
+}
+
+// renderImportBlock renders an import block that imports the object with
+// the given ID into the given resource address.
+func renderImportBlock(resourceType string, label string, id string) string {
+	return fmt.Sprintf("import {\n%[1]sto = %[2]s.%[3]s\n%[1]sid = %[4]s\n}\n", indentUnit, resourceType, label, quoteString(id))
+}
+
+// renderBody renders the attributes and nested blocks of the given object
+// value at the given nesting depth.
+func renderBody(depth int, attributes map[string]schema.Attribute, blocks map[string]schema.Block, value tftypes.Value) (string, error) {
+	var values map[string]tftypes.Value
+	if err := value.As(&values); err != nil {
+		return "", err
+	}
+
+	indent := strings.Repeat(indentUnit, depth)
+
+	names := make([]string, 0, len(attributes))
+	for name, attribute := range attributes {
+		if isRenderable(attribute, values[name]) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	expressions := make([]string, len(names))
+	for i, name := range names {
+		expression, err := renderExpression(depth, nestedAttributes(attributes[name]), values[name])
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", name, err)
+		}
+		expressions[i] = expression
+	}
+
+	var b strings.Builder
+	writeAttributes(&b, indent, names, expressions)
+	writeMissingAttributes(&b, indent, missingRequiredAttributes(attributes, values))
+
+	blockNames := make([]string, 0, len(blocks))
+	for name := range blocks {
+		if v, ok := values[name]; ok && v.IsKnown() && !v.IsNull() {
+			blockNames = append(blockNames, name)
+		}
+	}
+	sort.Strings(blockNames)
+
+	for _, name := range blockNames {
+		var (
+			nestedAttrs  map[string]schema.Attribute
+			nestedBlocks map[string]schema.Block
+			elements     []tftypes.Value
+		)
+
+		switch block := blocks[name].(type) {
+		case schema.SingleNestedBlock:
+			nestedAttrs, nestedBlocks = block.Attributes, block.Blocks
+			elements = []tftypes.Value{values[name]}
+		case schema.ListNestedBlock:
+			nestedAttrs, nestedBlocks = block.NestedObject.Attributes, block.NestedObject.Blocks
+			if err := values[name].As(&elements); err != nil {
+				return "", fmt.Errorf("%s: %w", name, err)
+			}
+		case schema.SetNestedBlock:
+			nestedAttrs, nestedBlocks = block.NestedObject.Attributes, block.NestedObject.Blocks
+			if err := values[name].As(&elements); err != nil {
+				return "", fmt.Errorf("%s: %w", name, err)
+			}
+		default:
+			return "", fmt.Errorf("%s: unsupported block type %T", name, block)
+		}
+
+		for _, element := range elements {
+			nestedBody, err := renderBody(depth+1, nestedAttrs, nestedBlocks, element)
+			if err != nil {
+				return "", fmt.Errorf("%s: %w", name, err)
+			}
+			fmt.Fprintf(&b, "\n%s%s {\n%s%s}\n", indent, name, nestedBody, indent)
+		}
+	}
+
+	return b.String(), nil
+}
+
+// writeAttributes writes the given attribute names and expressions to b,
+// aligning the equals signs of consecutive single-line attributes.
+func writeAttributes(b *strings.Builder, indent string, names []string, expressions []string) {
+	for start := 0; start < len(names); {
+		end, width := start, 0
+		for end < len(names) {
+			if w := utf8.RuneCountInString(names[end]); w > width {
+				width = w
+			}
+			end++
+			if strings.Contains(expressions[end-1], "\n") {
+				break
+			}
+		}
+
+		for i := start; i < end; i++ {
+			fmt.Fprintf(b, "%s%-*s = %s\n", indent, width, names[i], expressions[i])
+		}
+		start = end
+	}
+}
+
+// writeMissingAttributes writes a TODO comment to b for each of the given
+// required attribute names.
+func writeMissingAttributes(b *strings.Builder, indent string, names []string) {
+	for _, name := range names {
+		fmt.Fprintf(b, "%s# TODO: set %s, which is required and cannot be read back from the tenant\n", indent, name)
+	}
+}
+
+// missingRequiredAttributes returns the sorted names of the required
+// attributes that are not rendered, such as sensitive attributes and
+// attributes that the API never returns.
+func missingRequiredAttributes(attributes map[string]schema.Attribute, values map[string]tftypes.Value) []string {
+	names := []string{}
+	for name, attribute := range attributes {
+		if attribute.IsRequired() && !isRenderable(attribute, values[name]) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// isRenderable returns whether the given attribute and value should be
+// written to the generated configuration.
+func isRenderable(attribute schema.Attribute, value tftypes.Value) bool {
+	if !attribute.IsRequired() && !attribute.IsOptional() {
+		return false
+	}
+	if attribute.IsSensitive() || attribute.GetDeprecationMessage() != "" {
+		return false
+	}
+
+	return value.IsKnown() && !value.IsNull()
+}
+
+// nestedAttributes returns the attributes of the nested object of the given
+// attribute, or nil if it is not a nested attribute.
+func nestedAttributes(attribute schema.Attribute) map[string]schema.Attribute {
+	switch a := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return a.Attributes
+	case schema.ListNestedAttribute:
+		return a.NestedObject.Attributes
+	case schema.SetNestedAttribute:
+		return a.NestedObject.Attributes
+	case schema.MapNestedAttribute:
+		return a.NestedObject.Attributes
+	}
+
+	return nil
+}
+
+// renderExpression renders the given value as an HCL expression. If nested
+// is not nil, object values are rendered using the given nested attribute
+// definitions.
+func renderExpression(depth int, nested map[string]schema.Attribute, value tftypes.Value) (string, error) {
+	if !value.IsKnown() {
+		return "", fmt.Errorf("unexpected unknown value")
+	}
+	if value.IsNull() {
+		return "null", nil
+	}
+
+	typ := value.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return "", err
+		}
+		return quoteString(s), nil
+
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		if err := value.As(&n); err != nil {
+			return "", err
+		}
+		return n.Text('f', -1), nil
+
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := value.As(&b); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%t", b), nil
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return "", err
+		}
+		return renderSequence(depth, nested, elements)
+
+	case typ.Is(tftypes.Object{}):
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return "", err
+		}
+		return renderObject(depth, nested, attributes)
+
+	case typ.Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return "", err
+		}
+		return renderMap(depth, nested, elements)
+	}
+
+	return "", fmt.Errorf("unsupported value type %s", typ)
+}
+
+// renderSequence renders a list, set or tuple value. Sequences of primitive
+// values are rendered on a single line.
+func renderSequence(depth int, nested map[string]schema.Attribute, elements []tftypes.Value) (string, error) {
+	if len(elements) == 0 {
+		return "[]", nil
+	}
+
+	rendered := make([]string, len(elements))
+	multiline := false
+	for i, element := range elements {
+		expression, err := renderExpression(depth+1, nested, element)
+		if err != nil {
+			return "", err
+		}
+		rendered[i] = expression
+		if strings.Contains(expression, "\n") || !isPrimitive(element.Type()) {
+			multiline = true
+		}
+	}
+
+	if !multiline {
+		return "[" + strings.Join(rendered, ", ") + "]", nil
+	}
+
+	indent := strings.Repeat(indentUnit, depth)
+	var b strings.Builder
+	b.WriteString("[\n")
+	for _, expression := range rendered {
+		fmt.Fprintf(&b, "%s%s%s,\n", indent, indentUnit, expression)
+	}
+	b.WriteString(indent + "]")
+
+	return b.String(), nil
+}
+
+// renderObject renders an object value. If nested is not nil, only the
+// renderable attributes of the nested object are included.
+func renderObject(depth int, nested map[string]schema.Attribute, attributes map[string]tftypes.Value) (string, error) {
+	names := make([]string, 0, len(attributes))
+	for name, value := range attributes {
+		if nested != nil {
+			attribute, ok := nested[name]
+			if !ok || !isRenderable(attribute, value) {
+				continue
+			}
+		} else if !value.IsKnown() || value.IsNull() {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var missing []string
+	if nested != nil {
+		missing = missingRequiredAttributes(nested, attributes)
+	}
+
+	if len(names) == 0 && len(missing) == 0 {
+		return "{}", nil
+	}
+
+	expressions := make([]string, len(names))
+	for i, name := range names {
+		var nestedAttrs map[string]schema.Attribute
+		if nested != nil {
+			nestedAttrs = nestedAttributes(nested[name])
+		}
+		expression, err := renderExpression(depth+1, nestedAttrs, attributes[name])
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", name, err)
+		}
+		expressions[i] = expression
+	}
+
+	indent := strings.Repeat(indentUnit, depth)
+	var b strings.Builder
+	b.WriteString("{\n")
+	writeAttributes(&b, indent+indentUnit, names, expressions)
+	writeMissingAttributes(&b, indent+indentUnit, missing)
+	b.WriteString(indent + "}")
+
+	return b.String(), nil
+}
+
+// renderMap renders a map value. Keys are always quoted, as map keys are
+// not guaranteed to be valid identifiers.
+func renderMap(depth int, nested map[string]schema.Attribute, elements map[string]tftypes.Value) (string, error) {
+	if len(elements) == 0 {
+		return "{}", nil
+	}
+
+	keys := make([]string, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	quotedKeys := make([]string, len(keys))
+	expressions := make([]string, len(keys))
+	for i, key := range keys {
+		expression, err := renderExpression(depth+1, nested, elements[key])
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", key, err)
+		}
+		quotedKeys[i] = quoteString(key)
+		expressions[i] = expression
+	}
+
+	indent := strings.Repeat(indentUnit, depth)
+	var b strings.Builder
+	b.WriteString("{\n")
+	writeAttributes(&b, indent+indentUnit, quotedKeys, expressions)
+	b.WriteString(indent + "}")
+
+	return b.String(), nil
+}
+
+// isPrimitive returns whether the given type is a string, number or bool.
+func isPrimitive(typ tftypes.Type) bool {
+	return typ.Is(tftypes.String) || typ.Is(tftypes.Number) || typ.Is(tftypes.Bool)
+}
+
+// quoteString returns s as a quoted HCL string literal. In addition to the
+// standard escape sequences, template sequences are escaped so that values
+// containing "${" or "%{" are not interpreted by Terraform.
+func quoteString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '$', '%':
+			if i+1 < len(s) && s[i+1] == '{' {
+				b.WriteRune(r)
+			}
+			b.WriteRune(r)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// resourceLabel derives a valid and unique resource label from the given
+// object name. The used map tracks the labels already assigned to the
+// resource type.
+func resourceLabel(name string, used map[string]bool) string {
+	var b strings.Builder
+	lastUnderscore := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			lastUnderscore = false
+		} else if !lastUnderscore {
+			b.WriteByte('_')
+			lastUnderscore = true
+		}
+	}
+
+	label := strings.Trim(b.String(), "_")
+	if label == "" {
+		label = "unnamed"
+	}
+	if label[0] >= '0' && label[0] <= '9' {
+		label = "_" + label
+	}
+
+	unique := label
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	used[unique] = true
+
+	return unique
+}
@@ -0,0 +1,74 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package export
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderResourceBlock_MissingRequiredAttributes(t *testing.T) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"client_secret": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"connection": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						Required: true,
+					},
+					"password": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+	}
+
+	connectionType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"host":     tftypes.String,
+		"password": tftypes.String,
+	}}
+	state := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":          tftypes.String,
+		"client_secret": tftypes.String,
+		"description":   tftypes.String,
+		"connection":    connectionType,
+	}}, map[string]tftypes.Value{
+		"name":          tftypes.NewValue(tftypes.String, "example"),
+		"client_secret": tftypes.NewValue(tftypes.String, nil),
+		"description":   tftypes.NewValue(tftypes.String, nil),
+		"connection": tftypes.NewValue(connectionType, map[string]tftypes.Value{
+			"host":     tftypes.NewValue(tftypes.String, "example.com"),
+			"password": tftypes.NewValue(tftypes.String, nil),
+		}),
+	})
+
+	block, err := renderResourceBlock("cortexcloud_example", "example", s, state)
+	require.NoError(t, err)
+
+	assert.Equal(t, `resource "cortexcloud_example" "example" {
+  connection = {
+    host = "example.com"
+    # TODO: set password, which is required and cannot be read back from the tenant
+  }
+  name = "example"
+  # TODO: set client_secret, which is required and cannot be read back from the tenant
+}
+`, block)
+}
@@ -6,9 +6,9 @@ package models
 import (
 	"context"
 	"encoding/json"
-	"reflect"
 
 	appsecTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/appsec"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -94,26 +94,26 @@ var ImageRegistryTriggerAttrTypes = triggerObjectAttrTypes(ImageRegistryTriggerA
 // complexity (up to 10 levels). Triggers are exposed as five strongly-typed
 // nested objects (one per trigger type) since each has a fixed shape.
 type PolicyModel struct {
-	ID                          types.String  `tfsdk:"id"`
-	Name                        types.String  `tfsdk:"name"`
-	Description                 types.String  `tfsdk:"description"`
-	Status                      types.String  `tfsdk:"status"`
-	IsCustom                    types.Bool    `tfsdk:"is_custom"`
-	Conditions                  types.String  `tfsdk:"conditions"` // JSON string
-	Scope                       types.String  `tfsdk:"scope"`      // JSON string
-	AssetGroupIds               types.List    `tfsdk:"asset_group_ids"`
-	PeriodicTrigger             types.Object  `tfsdk:"periodic_trigger"`
-	PRTrigger                   types.Object  `tfsdk:"pr_trigger"`
-	CICDTrigger                 types.Object  `tfsdk:"cicd_trigger"`
-	CIImageTrigger              types.Object  `tfsdk:"ci_image_trigger"`
-	ImageRegistryTrigger        types.Object  `tfsdk:"image_registry_trigger"`
-	DeveloperSuppressionAffects types.Bool    `tfsdk:"developer_suppression_affects"`
-	OverrideIssueSeverity       types.String  `tfsdk:"override_issue_severity"`
-	CreatedBy                   types.String  `tfsdk:"created_by"`
-	DateCreated                 types.String  `tfsdk:"date_created"`
-	ModifiedBy                  types.String  `tfsdk:"modified_by"`
-	DateModified                types.String  `tfsdk:"date_modified"`
-	Version                     types.Float64 `tfsdk:"version"`
+	ID                          types.String        `tfsdk:"id"`
+	Name                        types.String        `tfsdk:"name"`
+	Description                 types.String        `tfsdk:"description"`
+	Status                      types.String        `tfsdk:"status"`
+	IsCustom                    types.Bool          `tfsdk:"is_custom"`
+	Conditions                  util.NormalizedJSON `tfsdk:"conditions"` // JSON string
+	Scope                       util.NormalizedJSON `tfsdk:"scope"`      // JSON string
+	AssetGroupIds               types.List          `tfsdk:"asset_group_ids"`
+	PeriodicTrigger             types.Object        `tfsdk:"periodic_trigger"`
+	PRTrigger                   types.Object        `tfsdk:"pr_trigger"`
+	CICDTrigger                 types.Object        `tfsdk:"cicd_trigger"`
+	CIImageTrigger              types.Object        `tfsdk:"ci_image_trigger"`
+	ImageRegistryTrigger        types.Object        `tfsdk:"image_registry_trigger"`
+	DeveloperSuppressionAffects types.Bool          `tfsdk:"developer_suppression_affects"`
+	OverrideIssueSeverity       types.String        `tfsdk:"override_issue_severity"`
+	CreatedBy                   types.String        `tfsdk:"created_by"`
+	DateCreated                 types.String        `tfsdk:"date_created"`
+	ModifiedBy                  types.String        `tfsdk:"modified_by"`
+	DateModified                types.String        `tfsdk:"date_modified"`
+	Version                     types.Float64       `tfsdk:"version"`
 }
 
 // RefreshFromRemote updates the Terraform model from the SDK response.
@@ -144,24 +144,25 @@ func (m *PolicyModel) RefreshFromRemote(ctx context.Context, diags *diag.Diagnos
 		m.OverrideIssueSeverity = types.StringNull()
 	}
 
-	// Convert conditions to JSON, preserving user's string when semantically equal
+	// Convert conditions to JSON. The configured string is preserved by the
+	// framework when it is semantically equal to the API response.
 	conditionsJSON, err := json.Marshal(remote.Conditions)
 	if err != nil {
 		diags.AddError("Error Marshaling Conditions", err.Error())
 		return
 	}
-	m.Conditions = preserveJSONIfEqual(m.Conditions, string(conditionsJSON))
+	m.Conditions = util.NewNormalizedJSONValue(string(conditionsJSON))
 
-	// Convert scope to JSON (optional), preserving user's string when semantically equal
+	// Convert scope to JSON (optional)
 	if remote.Scope != nil {
 		scopeJSON, err := json.Marshal(remote.Scope)
 		if err != nil {
 			diags.AddError("Error Marshaling Scope", err.Error())
 			return
 		}
-		m.Scope = preserveJSONIfEqual(m.Scope, string(scopeJSON))
+		m.Scope = util.NewNormalizedJSONValue(string(scopeJSON))
 	} else {
-		m.Scope = types.StringNull()
+		m.Scope = util.NewNormalizedJSONNull()
 	}
 
 	// Build the five trigger objects from the SDK response.
@@ -511,26 +512,3 @@ func buildImageRegistryActionsObject(diags *diag.Diagnostics, a appsecTypes.Trig
 	diags.Append(d...)
 	return obj
 }
-
-// preserveJSONIfEqual compares two JSON strings semantically (ignoring key
-// order) and returns the current state value if they are equal, avoiding
-// spurious diffs. This handles the case where the API returns JSON with
-// different key ordering than the user's Terraform configuration.
-func preserveJSONIfEqual(current types.String, remoteJSON string) types.String {
-	if current.IsNull() || current.IsUnknown() {
-		return types.StringValue(remoteJSON)
-	}
-
-	var currentParsed, remoteParsed map[string]interface{}
-	if err := json.Unmarshal([]byte(current.ValueString()), &currentParsed); err != nil {
-		return types.StringValue(remoteJSON)
-	}
-	if err := json.Unmarshal([]byte(remoteJSON), &remoteParsed); err != nil {
-		return types.StringValue(remoteJSON)
-	}
-
-	if reflect.DeepEqual(currentParsed, remoteParsed) {
-		return current
-	}
-	return types.StringValue(remoteJSON)
-}
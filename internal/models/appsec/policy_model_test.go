@@ -8,84 +8,11 @@ import (
 	"testing"
 
 	appsecTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/appsec"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func TestPreserveJSONIfEqual(t *testing.T) {
-	tests := []struct {
-		name        string
-		current     types.String
-		remoteJSON  string
-		expectValue string
-		expectNull  bool
-	}{
-		{
-			name:        "same key order — preserves current",
-			current:     types.StringValue(`{"a":"1","b":"2"}`),
-			remoteJSON:  `{"a":"1","b":"2"}`,
-			expectValue: `{"a":"1","b":"2"}`,
-		},
-		{
-			name:        "different key order — preserves current (semantically equal)",
-			current:     types.StringValue(`{"b":"2","a":"1"}`),
-			remoteJSON:  `{"a":"1","b":"2"}`,
-			expectValue: `{"b":"2","a":"1"}`,
-		},
-		{
-			name:        "different values — uses remote",
-			current:     types.StringValue(`{"a":"1","b":"2"}`),
-			remoteJSON:  `{"a":"1","b":"3"}`,
-			expectValue: `{"a":"1","b":"3"}`,
-		},
-		{
-			name:        "null current — uses remote",
-			current:     types.StringNull(),
-			remoteJSON:  `{"a":"1"}`,
-			expectValue: `{"a":"1"}`,
-		},
-		{
-			name:        "unknown current — uses remote",
-			current:     types.StringUnknown(),
-			remoteJSON:  `{"a":"1"}`,
-			expectValue: `{"a":"1"}`,
-		},
-		{
-			name:        "nested objects with different key order — preserves current",
-			current:     types.StringValue(`{"AND":[{"SEARCH_FIELD":"a"},{"SEARCH_FIELD":"b"}]}`),
-			remoteJSON:  `{"AND":[{"SEARCH_FIELD":"a"},{"SEARCH_FIELD":"b"}]}`,
-			expectValue: `{"AND":[{"SEARCH_FIELD":"a"},{"SEARCH_FIELD":"b"}]}`,
-		},
-		{
-			name:        "invalid current JSON — uses remote",
-			current:     types.StringValue(`{invalid json`),
-			remoteJSON:  `{"a":"1"}`,
-			expectValue: `{"a":"1"}`,
-		},
-		{
-			name:        "arrays with different order — uses remote (arrays are order-sensitive)",
-			current:     types.StringValue(`{"AND":[{"SEARCH_FIELD":"b"},{"SEARCH_FIELD":"a"}]}`),
-			remoteJSON:  `{"AND":[{"SEARCH_FIELD":"a"},{"SEARCH_FIELD":"b"}]}`,
-			expectValue: `{"AND":[{"SEARCH_FIELD":"a"},{"SEARCH_FIELD":"b"}]}`,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			got := preserveJSONIfEqual(tc.current, tc.remoteJSON)
-			if tc.expectNull {
-				if !got.IsNull() {
-					t.Errorf("preserveJSONIfEqual() = %q, want null", got.ValueString())
-				}
-				return
-			}
-			if got.ValueString() != tc.expectValue {
-				t.Errorf("preserveJSONIfEqual() = %q, want %q", got.ValueString(), tc.expectValue)
-			}
-		})
-	}
-}
-
 func TestPolicyModelRefreshFromRemote_Version(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -247,8 +174,8 @@ func TestPolicyModel_ToCreateRequest_OmittedTriggersGetCanonicalDefaults(t *test
 	model := PolicyModel{
 		Name:                 types.StringValue("p"),
 		Description:          types.StringValue(""),
-		Conditions:           types.StringValue(`{"SEARCH_FIELD":"x","SEARCH_TYPE":"EQ","SEARCH_VALUE":"y"}`),
-		Scope:                types.StringNull(),
+		Conditions:           util.NewNormalizedJSONValue(`{"SEARCH_FIELD":"x","SEARCH_TYPE":"EQ","SEARCH_VALUE":"y"}`),
+		Scope:                util.NewNormalizedJSONNull(),
 		PeriodicTrigger:      types.ObjectNull(PeriodicTriggerAttrTypes),
 		PRTrigger:            types.ObjectNull(PRTriggerAttrTypes),
 		CICDTrigger:          types.ObjectNull(CICDTriggerAttrTypes),
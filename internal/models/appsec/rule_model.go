@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	appsecTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/appsec"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -32,6 +33,7 @@ type RuleModel struct {
 	Description     types.String `tfsdk:"description"`
 	Frameworks      types.List   `tfsdk:"frameworks"`
 	Labels          types.List   `tfsdk:"labels"`
+	EffectiveLabels types.Set    `tfsdk:"effective_labels"`
 	IsCustom        types.Bool   `tfsdk:"is_custom"`
 	IsEnabled       types.Bool   `tfsdk:"is_enabled"`
 	CloudProvider   types.String `tfsdk:"cloud_provider"`
@@ -166,6 +168,11 @@ func (m *RuleModel) RefreshFromRemote(ctx context.Context, diags *diag.Diagnosti
 		}
 		m.Labels = labelList
 	}
+	if remote.Labels == nil {
+		m.EffectiveLabels = types.SetNull(types.StringType)
+	} else {
+		m.EffectiveLabels = util.LabelsSetValue(ctx, *remote.Labels, diags)
+	}
 }
 
 // ToCreateRequest converts the Terraform model to an SDK create request.
@@ -203,10 +210,8 @@ func (m *RuleModel) ToCreateRequest(ctx context.Context, diags *diag.Diagnostics
 		}
 	}
 
-	// Convert labels
-	if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
-		var labels []string
-		diags.Append(m.Labels.ElementsAs(ctx, &labels, false)...)
+	// Convert labels, including the provider's default labels
+	if labels, ok := util.RequestLabels(ctx, m.Labels, m.EffectiveLabels, diags); ok {
 		if diags.HasError() {
 			return req
 		}
@@ -250,10 +255,9 @@ func (m *RuleModel) ToUpdateRequest(ctx context.Context, diags *diag.Diagnostics
 		}
 	}
 
-	// Convert labels (required field)
-	if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
-		var labels []string
-		diags.Append(m.Labels.ElementsAs(ctx, &labels, false)...)
+	// Convert labels (required field), including the provider's default
+	// labels
+	if labels, ok := util.RequestLabels(ctx, m.Labels, m.EffectiveLabels, diags); ok {
 		if diags.HasError() {
 			return req
 		}
@@ -9,9 +9,7 @@ import (
 	"slices"
 
 	"github.com/PaloAltoNetworks/cortex-cloud-go/enums"
-	cortexEnums "github.com/PaloAltoNetworks/cortex-cloud-go/enums"
 	cloudOnboardingTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/cloudonboarding"
-	filterTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/filter"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -20,20 +18,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// CloudIntegrationTemplateAzureModel is the model for the cloud_integration_template_azure resource.
 type CloudIntegrationTemplateAzureModel struct {
-	AccountDetails          types.Object `tfsdk:"account_details"`
-	AdditionalCapabilities  types.Object `tfsdk:"additional_capabilities"`
-	CollectionConfiguration types.Object `tfsdk:"collection_configuration"`
-	CustomResourcesTags     types.Set    `tfsdk:"custom_resources_tags"`
-	InstanceName            types.String `tfsdk:"instance_name"`
-	ScanMode                types.String `tfsdk:"scan_mode"`
-	Scope                   types.String `tfsdk:"scope"`
-	ScopeModifications      types.Object `tfsdk:"scope_modifications"`
-	Status                  types.String `tfsdk:"status"`
-	TrackingGUID            types.String `tfsdk:"tracking_guid"`
-	OutpostID               types.String `tfsdk:"outpost_id"`
-	TerraformModuleURL      types.String `tfsdk:"terraform_module_url"`
-	ARMTemplateURL          types.String `tfsdk:"arm_template_url"`
+	CloudIntegrationTemplateModel
+
+	AccountDetails     types.Object `tfsdk:"account_details"`
+	TerraformModuleURL types.String `tfsdk:"terraform_module_url"`
+	ARMTemplateURL     types.String `tfsdk:"arm_template_url"`
 }
 
 type scopeModificationsAzure struct {
@@ -47,8 +38,7 @@ type scopeModificationSubscriptions struct {
 	SubscriptionIDs *[]string `json:"subscription_ids,omitempty" tfsdk:"subscription_ids"`
 }
 
-func (m *CloudIntegrationTemplateAzureModel) ToCreateRequest(ctx context.Context, diagnostics *diag.Diagnostics) *cloudOnboardingTypes.CreateIntegrationTemplateRequest {
-	ctx = tflog.SetField(ctx, "resource_operation", "ToCreateRequest")
+func (m *CloudIntegrationTemplateAzureModel) toRequestOptions(ctx context.Context, diagnostics *diag.Diagnostics) []cloudOnboardingTypes.CreateIntegrationTemplateRequestOption {
 
 	var accountDetails cloudOnboardingTypes.AccountDetails
 	if !m.AccountDetails.IsNull() {
@@ -107,36 +97,31 @@ func (m *CloudIntegrationTemplateAzureModel) ToCreateRequest(ctx context.Context
 		options = append(options, cloudOnboardingTypes.WithOutpostID(m.OutpostID.ValueString()))
 	}
 
+	return options
+}
+
+func (m *CloudIntegrationTemplateAzureModel) ToCreateRequest(ctx context.Context, diagnostics *diag.Diagnostics) *cloudOnboardingTypes.CreateIntegrationTemplateRequest {
+	ctx = tflog.SetField(ctx, "resource_operation", "ToCreateRequest")
+
+	options := m.toRequestOptions(ctx, diagnostics)
+	if diagnostics.HasError() {
+		return nil
+	}
+
 	return cloudOnboardingTypes.NewCreateIntegrationTemplateRequest(options...)
 }
 
-func (m *CloudIntegrationTemplateAzureModel) ToGetRequest(ctx context.Context, diagnostics *diag.Diagnostics) *cloudOnboardingTypes.ListIntegrationInstancesRequest {
-	ctx = tflog.SetField(ctx, "resource_operation", "ToGetRequest")
-
-	filter := filterTypes.NewAndFilter(
-		filterTypes.NewSearchFilter(
-			cortexEnums.SearchFieldID.String(),
-			cortexEnums.SearchTypeEqualTo.String(),
-			m.TrackingGUID.ValueString(),
-		),
-		filterTypes.NewSearchFilter(
-			cortexEnums.SearchFieldStatus.String(),
-			cortexEnums.SearchTypeEqualTo.String(),
-			cortexEnums.IntegrationInstanceStatusPending.String(),
-		),
-	)
+// ToEditRequest builds the request used to modify the integration instance
+// associated with this template in place.
+func (m *CloudIntegrationTemplateAzureModel) ToEditRequest(ctx context.Context, diagnostics *diag.Diagnostics) *cloudOnboardingTypes.EditIntegrationInstanceRequest {
+	ctx = tflog.SetField(ctx, "resource_operation", "ToEditRequest")
 
-	return cloudOnboardingTypes.NewListIntegrationInstancesRequest(
-		cloudOnboardingTypes.WithIntegrationFilterData(
-			filterTypes.FilterData{
-				Filter: filter,
-				Paging: filterTypes.PagingFilter{
-					From: 0,
-					To:   1000,
-				},
-			},
-		),
-	)
+	options := m.toRequestOptions(ctx, diagnostics)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	return cloudOnboardingTypes.NewEditIntegrationInstanceRequest(m.TrackingGUID.ValueString(), options...)
 }
 
 func (m *CloudIntegrationTemplateAzureModel) SetGeneratedValues(ctx context.Context, diagnostics *diag.Diagnostics, response cloudOnboardingTypes.CreateTemplateOrEditIntegrationInstanceResponse) {
@@ -223,3 +208,24 @@ func (m *CloudIntegrationTemplateAzureModel) RefreshConfiguredPropertyValues(ctx
 	m.ScanMode = types.StringValue(remote.Scan.ScanMethod)
 	m.Status = types.StringValue(remote.Status)
 }
+
+// RefreshImportedPropertyValues populates the model from the given
+// integration instance when the resource is being imported. Values that are
+// only returned when the template is created, such as the deployment URLs,
+// are left null.
+func (m *CloudIntegrationTemplateAzureModel) RefreshImportedPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, remote cloudOnboardingTypes.IntegrationInstance) {
+	ctx = tflog.SetField(ctx, "resource_operation", "RefreshImportedPropertyValues")
+
+	collectionConfiguration, diags := types.ObjectValueFrom(ctx, m.CollectionConfiguration.AttributeTypes(ctx), remote.CollectionConfiguration)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	m.TrackingGUID = types.StringValue(remote.ID)
+	m.CollectionConfiguration = collectionConfiguration
+	m.Scope = types.StringValue(remote.Scope)
+	m.DestroyAction = types.StringValue(DestroyActionDisable)
+
+	m.RefreshConfiguredPropertyValues(ctx, diagnostics, remote)
+}
@@ -5,14 +5,57 @@ package models
 
 import (
 	cloudOnboardingTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/cloudonboarding"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// CloudIntegrationTemplateModel contains the attributes shared by every
+// cloud integration template resource. It is embedded in the model of each
+// cloud-specific resource.
+type CloudIntegrationTemplateModel struct {
+	AdditionalCapabilities  types.Object `tfsdk:"additional_capabilities"`
+	CollectionConfiguration types.Object `tfsdk:"collection_configuration"`
+	CustomResourcesTags     types.Set    `tfsdk:"custom_resources_tags"`
+	InstanceName            types.String `tfsdk:"instance_name"`
+	ScanMode                types.String `tfsdk:"scan_mode"`
+	Scope                   types.String `tfsdk:"scope"`
+	ScopeModifications      types.Object `tfsdk:"scope_modifications"`
+	Status                  types.String `tfsdk:"status"`
+	TrackingGUID            types.String `tfsdk:"tracking_guid"`
+	OutpostID               types.String `tfsdk:"outpost_id"`
+	DestroyAction           types.String `tfsdk:"destroy_action"`
+}
+
+// Template returns the attributes shared by every cloud integration
+// template resource.
+func (m *CloudIntegrationTemplateModel) Template() *CloudIntegrationTemplateModel {
+	return m
+}
+
 type scopeModificationRegions struct {
 	Enabled bool      `json:"enabled" tfsdk:"enabled"`
 	Type    *string   `json:"type,omitempty" tfsdk:"type"`
 	Regions *[]string `json:"regions,omitempty" tfsdk:"regions"`
 }
 
+const (
+	// DestroyActionDelete deletes the integration instance when the template
+	// resource is destroyed.
+	DestroyActionDelete = "DELETE"
+	// DestroyActionDisable disables the integration instance when the
+	// template resource is destroyed, leaving it visible in the console.
+	DestroyActionDisable = "DISABLE"
+)
+
+// AllDestroyActions returns every supported value for the destroy_action
+// attribute of the cloud integration template resources.
+func AllDestroyActions() []string {
+	return []string{
+		DestroyActionDelete,
+		DestroyActionDisable,
+	}
+}
+
 var defaultIntegrationTemplatePANWTag = cloudOnboardingTypes.Tag{
 	Key:   "managed_by",
 	Value: "paloaltonetworks",
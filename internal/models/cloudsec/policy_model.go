@@ -7,6 +7,7 @@ import (
 	"context"
 
 	cloudsecTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/cloudsec"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,18 +16,19 @@ import (
 
 // CloudSecPolicyResourceModel represents the Terraform model for a CloudSec policy resource.
 type CloudSecPolicyResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Description   types.String `tfsdk:"description"`
-	Labels        types.Set    `tfsdk:"labels"`
-	RuleMatching  types.Object `tfsdk:"rule_matching"`
-	AssetMatching types.Object `tfsdk:"asset_matching"`
-	Enabled       types.Bool   `tfsdk:"enabled"`
-	Mode          types.String `tfsdk:"mode"`
-	CreatedAt     types.Int64  `tfsdk:"created_at"`
-	CreatedBy     types.String `tfsdk:"created_by"`
-	UpdatedAt     types.Int64  `tfsdk:"updated_at"`
-	UpdatedBy     types.String `tfsdk:"updated_by"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Labels          types.Set    `tfsdk:"labels"`
+	EffectiveLabels types.Set    `tfsdk:"effective_labels"`
+	RuleMatching    types.Object `tfsdk:"rule_matching"`
+	AssetMatching   types.Object `tfsdk:"asset_matching"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Mode            types.String `tfsdk:"mode"`
+	CreatedAt       types.Int64  `tfsdk:"created_at"`
+	CreatedBy       types.String `tfsdk:"created_by"`
+	UpdatedAt       types.Int64  `tfsdk:"updated_at"`
+	UpdatedBy       types.String `tfsdk:"updated_by"`
 }
 
 // RuleMatchingModel represents the rule matching configuration.
@@ -72,10 +74,8 @@ func (m *CloudSecPolicyResourceModel) ToSDKCreateRequest(ctx context.Context, di
 		req.Description = m.Description.ValueString()
 	}
 
-	// Labels (optional)
-	if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
-		var labels []string
-		diags.Append(m.Labels.ElementsAs(ctx, &labels, false)...)
+	// Labels (optional), including the provider's default labels
+	if labels, ok := util.RequestLabels(ctx, m.Labels, m.EffectiveLabels, diags); ok {
 		req.Labels = labels
 	}
 
@@ -159,10 +159,8 @@ func (m *CloudSecPolicyResourceModel) ToSDKUpdateRequest(ctx context.Context, di
 		req.Description = m.Description.ValueString()
 	}
 
-	// Labels (optional)
-	if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
-		var labels []string
-		diags.Append(m.Labels.ElementsAs(ctx, &labels, false)...)
+	// Labels (optional), including the provider's default labels
+	if labels, ok := util.RequestLabels(ctx, m.Labels, m.EffectiveLabels, diags); ok {
 		req.Labels = labels
 	}
 
@@ -263,6 +261,7 @@ func (m *CloudSecPolicyResourceModel) FromSDKResponse(ctx context.Context, diags
 	} else {
 		m.Labels = types.SetNull(types.StringType)
 	}
+	m.EffectiveLabels = util.LabelsSetValue(ctx, remote.Labels, diags)
 
 	// Rule Matching
 	ruleMatchingAttrs := map[string]attr.Value{
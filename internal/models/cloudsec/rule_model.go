@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	cloudsecTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/cloudsec"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -27,6 +28,7 @@ type CloudSecRuleResourceModel struct {
 	Metadata           types.Object `tfsdk:"metadata"`
 	ComplianceMetadata types.List   `tfsdk:"compliance_metadata"`
 	Labels             types.Set    `tfsdk:"labels"`
+	EffectiveLabels    types.Set    `tfsdk:"effective_labels"`
 	Enabled            types.Bool   `tfsdk:"enabled"`
 	Providers          types.List   `tfsdk:"providers"`
 	SystemDefault      types.Bool   `tfsdk:"system_default"`
@@ -162,10 +164,8 @@ func (m *CloudSecRuleResourceModel) ToSDKCreateRequest(ctx context.Context, diag
 		}
 	}
 
-	// Labels (optional)
-	if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
-		var labels []string
-		diags.Append(m.Labels.ElementsAs(ctx, &labels, false)...)
+	// Labels (optional), including the provider's default labels
+	if labels, ok := util.RequestLabels(ctx, m.Labels, m.EffectiveLabels, diags); ok {
 		req.Labels = labels
 	}
 
@@ -263,11 +263,10 @@ func (m *CloudSecRuleResourceModel) ToSDKUpdateRequest(ctx context.Context, diag
 		}
 	}
 
-	// Labels — only include if changed
-	if !m.Labels.Equal(prior.Labels) {
-		if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
-			var labels []string
-			diags.Append(m.Labels.ElementsAs(ctx, &labels, false)...)
+	// Labels — only include if changed, including the provider's default
+	// labels
+	if !m.Labels.Equal(prior.Labels) || !m.EffectiveLabels.Equal(prior.EffectiveLabels) {
+		if labels, ok := util.RequestLabels(ctx, m.Labels, m.EffectiveLabels, diags); ok {
 			req.Labels = labels
 		}
 	}
@@ -358,6 +357,7 @@ func (m *CloudSecRuleResourceModel) FromSDKResponse(ctx context.Context, diags *
 	} else {
 		m.Labels = types.SetNull(types.StringType)
 	}
+	m.EffectiveLabels = util.LabelsSetValue(ctx, remote.Labels, diags)
 
 	// Query
 	if remote.Query != nil {
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	complianceTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/compliance"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,6 +23,7 @@ type StandardModel struct {
 	AssessmentsProfilesCount types.Int64  `tfsdk:"assessments_profiles_count"`
 	ControlsIDs              types.Set    `tfsdk:"controls_ids"`
 	Labels                   types.Set    `tfsdk:"labels"`
+	EffectiveLabels          types.Set    `tfsdk:"effective_labels"`
 	Revision                 types.Int64  `tfsdk:"revision"`
 	Publisher                types.String `tfsdk:"publisher"`
 	ReleaseDate              types.String `tfsdk:"release_date"`
@@ -87,6 +89,7 @@ func (m *StandardModel) RefreshFromRemote(ctx context.Context, diags *diag.Diagn
 		}
 		m.Labels = setValue
 	}
+	m.EffectiveLabels = util.LabelsSetValue(ctx, remote.Labels, diags)
 }
 
 // ToCreateRequest converts the Terraform model to an SDK create request.
@@ -108,10 +111,8 @@ func (m *StandardModel) ToCreateRequest(ctx context.Context, diags *diag.Diagnos
 		req.ControlsIDs = controlsIDs
 	}
 
-	// Convert labels
-	if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
-		var labels []string
-		diags.Append(m.Labels.ElementsAs(ctx, &labels, false)...)
+	// Convert labels, including the provider's default labels
+	if labels, ok := util.RequestLabels(ctx, m.Labels, m.EffectiveLabels, diags); ok {
 		if diags.HasError() {
 			return req
 		}
@@ -144,10 +145,9 @@ func (m *StandardModel) ToUpdateRequest(ctx context.Context, diags *diag.Diagnos
 		req.ControlsIDs = controlsIDs
 	}
 
-	// Convert labels (required field, must be present)
-	if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
-		var labels []string
-		diags.Append(m.Labels.ElementsAs(ctx, &labels, false)...)
+	// Convert labels (required field, must be present), including the
+	// provider's default labels
+	if labels, ok := util.RequestLabels(ctx, m.Labels, m.EffectiveLabels, diags); ok {
 		if diags.HasError() {
 			return req
 		}
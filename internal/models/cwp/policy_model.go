@@ -7,6 +7,7 @@ import (
 	"context"
 
 	cwpTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/cwp"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -16,26 +17,26 @@ import (
 
 // PolicyModel is the Terraform model for a CWP policy.
 type PolicyModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Revision            types.Int64  `tfsdk:"revision"`
-	CreatedAt           types.String `tfsdk:"created_at"`
-	ModifiedAt          types.String `tfsdk:"modified_at"`
-	Type                types.String `tfsdk:"type"`
-	CreatedBy           types.String `tfsdk:"created_by"`
-	Disabled            types.Bool   `tfsdk:"disabled"`
-	Name                types.String `tfsdk:"name"`
-	Description         types.String `tfsdk:"description"`
-	EvaluationModes     types.List   `tfsdk:"evaluation_modes"`
-	EvaluationStage     types.String `tfsdk:"evaluation_stage"`
-	PolicyRules         types.List   `tfsdk:"policy_rules"`
-	Condition           types.String `tfsdk:"condition"`
-	Exception           types.String `tfsdk:"exception"`
-	AssetScope          types.String `tfsdk:"asset_scope"`
-	AssetGroupIDs       types.List   `tfsdk:"asset_group_ids"`
-	AssetGroups         types.List   `tfsdk:"asset_groups"`
-	PolicyAction        types.String `tfsdk:"action"`
-	PolicySeverity      types.String `tfsdk:"severity"`
-	RemediationGuidance types.String `tfsdk:"remediation_guidance"`
+	ID                  types.String        `tfsdk:"id"`
+	Revision            types.Int64         `tfsdk:"revision"`
+	CreatedAt           types.String        `tfsdk:"created_at"`
+	ModifiedAt          types.String        `tfsdk:"modified_at"`
+	Type                types.String        `tfsdk:"type"`
+	CreatedBy           types.String        `tfsdk:"created_by"`
+	Disabled            types.Bool          `tfsdk:"disabled"`
+	Name                types.String        `tfsdk:"name"`
+	Description         types.String        `tfsdk:"description"`
+	EvaluationModes     types.List          `tfsdk:"evaluation_modes"`
+	EvaluationStage     types.String        `tfsdk:"evaluation_stage"`
+	PolicyRules         types.List          `tfsdk:"policy_rules"`
+	Condition           util.NormalizedJSON `tfsdk:"condition"`
+	Exception           util.NormalizedJSON `tfsdk:"exception"`
+	AssetScope          util.NormalizedJSON `tfsdk:"asset_scope"`
+	AssetGroupIDs       types.List          `tfsdk:"asset_group_ids"`
+	AssetGroups         types.List          `tfsdk:"asset_groups"`
+	PolicyAction        types.String        `tfsdk:"action"`
+	PolicySeverity      types.String        `tfsdk:"severity"`
+	RemediationGuidance types.String        `tfsdk:"remediation_guidance"`
 }
 
 // PoliciesDataSourceModel is the model for an individual CWP rule attached to
@@ -214,9 +215,9 @@ func (m *PolicyModel) RefreshFromRemote(ctx context.Context, diags *diag.Diagnos
 	m.Name = types.StringValue(remote.Name)
 	m.Description = types.StringValue(remote.Description)
 	m.EvaluationStage = types.StringValue(remote.EvaluationStage)
-	m.Condition = types.StringValue(remote.Condition)
-	m.Exception = types.StringValue(remote.Exception)
-	m.AssetScope = types.StringValue(remote.AssetScope)
+	m.Condition = util.NewNormalizedJSONValue(remote.Condition)
+	m.Exception = util.NewNormalizedJSONValue(remote.Exception)
+	m.AssetScope = util.NewNormalizedJSONValue(remote.AssetScope)
 	m.PolicyAction = types.StringValue(remote.PolicyAction)
 	m.PolicySeverity = types.StringValue(remote.PolicySeverity)
 	m.RemediationGuidance = types.StringValue(remote.RemediationGuidance)
@@ -41,3 +41,37 @@ func (m *AssetGroupModel) RefreshFromRemote(ctx context.Context, diags *diag.Dia
 	m.ModifiedBy = types.StringValue(remote.ModifiedBy)
 	m.MembershipPredicate = shared.SDKToModel(ctx, remote.MembershipPredicate)
 }
+
+// AssetGroupsDataSourceModel is the model for the asset_groups data source.
+type AssetGroupsDataSourceModel struct {
+	ID          types.String            `tfsdk:"id"`
+	Filter      *shared.RootFilterModel `tfsdk:"filter"`
+	AssetGroups []AssetGroupModel       `tfsdk:"asset_groups"`
+}
+
+// ToListRequest converts the data source model to an SDK list request.
+func (m *AssetGroupsDataSourceModel) ToListRequest(ctx context.Context) platformTypes.ListAssetGroupsRequest {
+	tflog.Debug(ctx, "Converting asset groups data source model to list request")
+
+	req := platformTypes.ListAssetGroupsRequest{}
+	if m.Filter != nil && (len(m.Filter.And) > 0 || len(m.Filter.Or) > 0) {
+		req.Filters = shared.RootModelToSDKFilter(ctx, m.Filter)
+	}
+
+	return req
+}
+
+// RefreshFromRemote updates the data source model from the SDK response.
+func (m *AssetGroupsDataSourceModel) RefreshFromRemote(ctx context.Context, diags *diag.Diagnostics, remote []platformTypes.AssetGroup) {
+	tflog.Debug(ctx, "Refreshing asset groups data source model from remote")
+
+	m.ID = types.StringValue("asset_groups")
+
+	m.AssetGroups = make([]AssetGroupModel, len(remote))
+	for i := range remote {
+		m.AssetGroups[i].RefreshFromRemote(ctx, diags, &remote[i])
+		if diags.HasError() {
+			return
+		}
+	}
+}
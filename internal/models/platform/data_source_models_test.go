@@ -0,0 +1,271 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+
+	platformtypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/platform"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testIamUser returns a user that is a member of the given groups, each of
+// which is supplied as an ID and name pair.
+func testIamUser(email, roleName, status, userType string, groups ...[2]string) platformtypes.IamUser {
+	user := platformtypes.IamUser{
+		Email:    email,
+		RoleName: roleName,
+		Status:   status,
+		UserType: userType,
+	}
+
+	user.Groups = slices.Grow(user.Groups, len(groups))[:len(groups)]
+	for i, group := range groups {
+		user.Groups[i].GroupID = group[0]
+		user.Groups[i].GroupName = group[1]
+	}
+
+	return user
+}
+
+// stringOrNull returns a null string for an empty value, which represents
+// a filter that is not configured.
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func TestUsersDataSourceModel_Matches(t *testing.T) {
+	user := testIamUser(
+		"jane.doe@example.com",
+		"Instance Administrator",
+		"ACTIVE",
+		"SSO",
+		[2]string{"group-1", "Security"},
+		[2]string{"group-2", "Operations"},
+	)
+
+	tests := []struct {
+		name         string
+		roleName     string
+		status       string
+		userType     string
+		groupID      string
+		groupName    string
+		emailPattern string
+		expected     bool
+	}{
+		{
+			name:     "no filters",
+			expected: true,
+		},
+		{
+			name:     "role name is case-insensitive",
+			roleName: "instance administrator",
+			expected: true,
+		},
+		{
+			name:     "different role name",
+			roleName: "Viewer",
+			expected: false,
+		},
+		{
+			name:     "status is case-insensitive",
+			status:   "active",
+			expected: true,
+		},
+		{
+			name:     "different status",
+			status:   "INACTIVE",
+			expected: false,
+		},
+		{
+			name:     "user type is case-insensitive",
+			userType: "sso",
+			expected: true,
+		},
+		{
+			name:     "different user type",
+			userType: "CSP",
+			expected: false,
+		},
+		{
+			name:         "matching email pattern",
+			emailPattern: `@example\.com$`,
+			expected:     true,
+		},
+		{
+			name:         "non-matching email pattern",
+			emailPattern: `@example\.org$`,
+			expected:     false,
+		},
+		{
+			name:     "member of group by ID",
+			groupID:  "group-2",
+			expected: true,
+		},
+		{
+			name:     "not a member of group by ID",
+			groupID:  "group-3",
+			expected: false,
+		},
+		{
+			name:      "member of group by name",
+			groupName: "Security",
+			expected:  true,
+		},
+		{
+			name:      "group name is case-sensitive",
+			groupName: "security",
+			expected:  false,
+		},
+		{
+			name:      "group ID and name must match the same group",
+			groupID:   "group-1",
+			groupName: "Operations",
+			expected:  false,
+		},
+		{
+			name:      "group ID and name of the same group",
+			groupID:   "group-2",
+			groupName: "Operations",
+			expected:  true,
+		},
+		{
+			name:         "all filters must match",
+			roleName:     "Instance Administrator",
+			status:       "ACTIVE",
+			groupName:    "Security",
+			emailPattern: `@example\.org$`,
+			expected:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := UsersDataSourceModel{
+				RoleName:     stringOrNull(tt.roleName),
+				Status:       stringOrNull(tt.status),
+				UserType:     stringOrNull(tt.userType),
+				GroupID:      stringOrNull(tt.groupID),
+				GroupName:    stringOrNull(tt.groupName),
+				EmailPattern: stringOrNull(tt.emailPattern),
+			}
+
+			var emailPattern *regexp.Regexp
+			if tt.emailPattern != "" {
+				emailPattern = regexp.MustCompile(tt.emailPattern)
+			}
+
+			if result := model.Matches(user, emailPattern); result != tt.expected {
+				t.Errorf("Matches() = %t, want %t", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUsersDataSourceModel_MatchesUserWithoutGroups(t *testing.T) {
+	model := UsersDataSourceModel{
+		GroupName: types.StringValue("Security"),
+	}
+
+	if model.Matches(testIamUser("john.doe@example.com", "Viewer", "ACTIVE", "SSO"), nil) {
+		t.Error("expected a user without groups not to match a group filter")
+	}
+}
+
+func TestUserGroupsDataSourceModel_Matches(t *testing.T) {
+	group := platformtypes.UserGroup{
+		GroupName: "Security Operations",
+		GroupType: "CUSTOM",
+		RoleName:  "role-1",
+		Users:     []string{"jane.doe@example.com", "john.doe@example.com"},
+	}
+
+	tests := []struct {
+		name        string
+		roleID      string
+		groupType   string
+		userEmail   string
+		namePattern string
+		expected    bool
+	}{
+		{
+			name:     "no filters",
+			expected: true,
+		},
+		{
+			name:     "matching role ID",
+			roleID:   "role-1",
+			expected: true,
+		},
+		{
+			name:     "different role ID",
+			roleID:   "role-2",
+			expected: false,
+		},
+		{
+			name:      "group type is case-insensitive",
+			groupType: "custom",
+			expected:  true,
+		},
+		{
+			name:      "different group type",
+			groupType: "IDP",
+			expected:  false,
+		},
+		{
+			name:      "member email is case-insensitive",
+			userEmail: "JANE.DOE@example.com",
+			expected:  true,
+		},
+		{
+			name:      "not a member",
+			userEmail: "someone.else@example.com",
+			expected:  false,
+		},
+		{
+			name:        "matching name pattern",
+			namePattern: `^Security`,
+			expected:    true,
+		},
+		{
+			name:        "non-matching name pattern",
+			namePattern: `^Operations`,
+			expected:    false,
+		},
+		{
+			name:        "all filters must match",
+			roleID:      "role-1",
+			groupType:   "CUSTOM",
+			userEmail:   "someone.else@example.com",
+			namePattern: `^Security`,
+			expected:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := UserGroupsDataSourceModel{
+				RoleID:      stringOrNull(tt.roleID),
+				GroupType:   stringOrNull(tt.groupType),
+				UserEmail:   stringOrNull(tt.userEmail),
+				NamePattern: stringOrNull(tt.namePattern),
+			}
+
+			var namePattern *regexp.Regexp
+			if tt.namePattern != "" {
+				namePattern = regexp.MustCompile(tt.namePattern)
+			}
+
+			if result := model.Matches(group, namePattern); result != tt.expected {
+				t.Errorf("Matches() = %t, want %t", result, tt.expected)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package models
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/PaloAltoNetworks/cortex-cloud-go/enums"
@@ -19,7 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
-	//"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	//"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -29,6 +30,11 @@ const (
 	EmailFormatIssue         = "ISSUE"
 	EmailFormatAlertStandard = "ALERT_STANDARD"
 	EmailFormatAlertLegacy   = "ALERT_LEGACY"
+
+	NotificationForwardingConfigurationTypeAgentAuditLogs = "agent_audit_logs"
+	NotificationForwardingConfigurationTypeMgmtAuditLogs  = "mgmt_audit_logs"
+	NotificationForwardingConfigurationTypeCases          = "cases"
+	NotificationForwardingConfigurationTypeIssues         = "issues"
 )
 
 var (
@@ -42,6 +48,23 @@ var (
 		EmailFormatAlertStandard,
 		EmailFormatAlertLegacy,
 	}
+
+	NotificationForwardingConfigurationTypeEnums = []string{
+		NotificationForwardingConfigurationTypeAgentAuditLogs,
+		NotificationForwardingConfigurationTypeMgmtAuditLogs,
+		NotificationForwardingConfigurationTypeCases,
+		NotificationForwardingConfigurationTypeIssues,
+	}
+
+	// notificationForwardingConfigurationTypes maps the forward types returned
+	// by the API to the configuration types used by the type attribute of the
+	// generic resource, which match the suffixes of the typed resource names.
+	notificationForwardingConfigurationTypes = map[string]string{
+		configTypeAgentAuditLogs:      NotificationForwardingConfigurationTypeAgentAuditLogs,
+		configTypeManagementAuditLogs: NotificationForwardingConfigurationTypeMgmtAuditLogs,
+		configTypeCases:               NotificationForwardingConfigurationTypeCases,
+		configTypeIssues:              NotificationForwardingConfigurationTypeIssues,
+	}
 )
 
 // ----------------------------------------------------------------------------
@@ -252,7 +275,12 @@ func (m *NotificationForwardingConfigurationAgentAuditLogsModel) Type() string {
 }
 
 func (m *NotificationForwardingConfigurationAgentAuditLogsModel) ValidateConfig(ctx context.Context, diags *diag.Diagnostics, resp *resource.ValidateConfigResponse) {
-	return
+	validateNotificationForwardingScope(diags, NotificationForwardingConfigurationTypeAgentAuditLogs, m.Shared.Scope)
+
+	validateForwardingDestinations(diags, map[string]bool{
+		"email_config":  m.EmailConfig != nil,
+		"syslog_config": m.SyslogConfig != nil,
+	})
 }
 
 func (m *NotificationForwardingConfigurationAgentAuditLogsModel) FromTfsdk(ctx context.Context, diags *diag.Diagnostics, config ITfsdkConfig) {
@@ -393,7 +421,12 @@ func (m *NotificationForwardingConfigurationMgmtAuditLogsModel) Type() string {
 }
 
 func (m *NotificationForwardingConfigurationMgmtAuditLogsModel) ValidateConfig(ctx context.Context, diags *diag.Diagnostics, resp *resource.ValidateConfigResponse) {
-	return
+	validateNotificationForwardingScope(diags, NotificationForwardingConfigurationTypeMgmtAuditLogs, m.Shared.Scope)
+
+	validateForwardingDestinations(diags, map[string]bool{
+		"email_config":  m.EmailConfig != nil,
+		"syslog_config": m.SyslogConfig != nil,
+	})
 }
 
 func (m *NotificationForwardingConfigurationMgmtAuditLogsModel) FromTfsdk(ctx context.Context, diags *diag.Diagnostics, config ITfsdkConfig) {
@@ -600,6 +633,19 @@ func (m *SyslogConfigIssuesModel) Equals(other *SyslogConfigIssuesModel) (isEqua
 	return true
 }
 
+func (m *NotificationForwardingConfigurationIssuesModel) Type() string {
+	return configTypeIssues
+}
+
+func (m *NotificationForwardingConfigurationIssuesModel) ValidateConfig(ctx context.Context, diags *diag.Diagnostics, resp *resource.ValidateConfigResponse) {
+	validateNotificationForwardingScope(diags, NotificationForwardingConfigurationTypeIssues, m.Shared.Scope)
+
+	validateForwardingDestinations(diags, map[string]bool{
+		"email_config":  m.EmailConfig != nil,
+		"syslog_config": m.SyslogConfig != nil,
+	})
+}
+
 func (m *NotificationForwardingConfigurationIssuesModel) FromTfsdk(ctx context.Context, diags *diag.Diagnostics, config ITfsdkConfig) {
 	(&m.Shared).FromTfsdk(ctx, diags, config)
 	if diags.HasError() {
@@ -747,6 +793,14 @@ type NotificationForwardingConfigurationCasesModel struct {
 	EmailConfig *EmailConfigModel `tfsdk:"email_config"`
 }
 
+func (m *NotificationForwardingConfigurationCasesModel) Type() string {
+	return configTypeCases
+}
+
+func (m *NotificationForwardingConfigurationCasesModel) ValidateConfig(ctx context.Context, diags *diag.Diagnostics, resp *resource.ValidateConfigResponse) {
+	validateNotificationForwardingScope(diags, NotificationForwardingConfigurationTypeCases, m.Shared.Scope)
+}
+
 func (m *NotificationForwardingConfigurationCasesModel) FromTfsdk(ctx context.Context, diags *diag.Diagnostics, config ITfsdkConfig) {
 	(&m.Shared).FromTfsdk(ctx, diags, config)
 	if diags.HasError() {
@@ -849,6 +903,363 @@ func (m *NotificationForwardingConfigurationCasesModel) RefreshFromRemote(ctx co
 	m.Shared.Timezone = types.StringValue(resp.TimeZone)
 }
 
+// ----------------------------------------------------------------------------
+// Generic
+// ----------------------------------------------------------------------------
+
+// NotificationForwardingConfigurationModel is the model for the
+// notification_forwarding_config resource, which manages configurations of
+// every type. The email and syslog settings use the Issues representation, as
+// it is a superset of the representations used by the other types.
+type NotificationForwardingConfigurationModel struct {
+	Shared       NotificationForwardingConfigurationSharedFields
+	ConfigType   types.String             `tfsdk:"type"`
+	EmailConfig  *EmailConfigIssuesModel  `tfsdk:"email_config"`
+	SyslogConfig *SyslogConfigIssuesModel `tfsdk:"syslog_config"`
+}
+
+// Type returns the forward type corresponding to the configured type.
+func (m *NotificationForwardingConfigurationModel) Type() string {
+	for forwardType, configType := range notificationForwardingConfigurationTypes {
+		if configType == m.ConfigType.ValueString() {
+			return forwardType
+		}
+	}
+
+	return m.ConfigType.ValueString()
+}
+
+// ValidateConfig checks that the configured forwarding destinations and
+// formats are supported by the configured type.
+func (m *NotificationForwardingConfigurationModel) ValidateConfig(ctx context.Context, diags *diag.Diagnostics, resp *resource.ValidateConfigResponse) {
+	if m.ConfigType.IsNull() || m.ConfigType.IsUnknown() {
+		return
+	}
+	configType := m.ConfigType.ValueString()
+
+	validateNotificationForwardingScope(diags, configType, m.Shared.Scope)
+
+	// Cases may only be forwarded by email
+	if configType == NotificationForwardingConfigurationTypeCases {
+		if m.SyslogConfig != nil {
+			diags.AddAttributeError(
+				path.Root("syslog_config"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The syslog_config attribute may not be configured when type is \"%s\".", configType),
+			)
+		}
+		return
+	}
+
+	// Only issues support custom email and syslog formats
+	if configType != NotificationForwardingConfigurationTypeIssues {
+		if m.EmailConfig != nil && !m.EmailConfig.Format.IsNull() {
+			diags.AddAttributeError(
+				path.Root("email_config").AtName("format"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The email_config.format attribute may only be configured when type is \"%s\", got \"%s\".", NotificationForwardingConfigurationTypeIssues, configType),
+			)
+		}
+		if m.SyslogConfig != nil && !m.SyslogConfig.Format.IsNull() {
+			diags.AddAttributeError(
+				path.Root("syslog_config").AtName("format"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The syslog_config.format attribute may only be configured when type is \"%s\", got \"%s\".", NotificationForwardingConfigurationTypeIssues, configType),
+			)
+		}
+	}
+
+	validateForwardingDestinations(diags, map[string]bool{
+		"email_config":  m.EmailConfig != nil,
+		"syslog_config": m.SyslogConfig != nil,
+	})
+}
+
+func (m *NotificationForwardingConfigurationModel) FromTfsdk(ctx context.Context, diags *diag.Diagnostics, config ITfsdkConfig) {
+	(&m.Shared).FromTfsdk(ctx, diags, config)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(config.GetAttribute(ctx, path.Root("type"), &m.ConfigType)...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(config.GetAttribute(ctx, path.Root("email_config"), &m.EmailConfig)...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(config.GetAttribute(ctx, path.Root("syslog_config"), &m.SyslogConfig)...)
+	if diags.HasError() {
+		return
+	}
+}
+
+// ToTfsdk sets the given Terraform state to the values of the model. It is
+// used by both the notification_forwarding_config resource and data source,
+// which share the same attributes.
+func (m *NotificationForwardingConfigurationModel) ToTfsdk(ctx context.Context, diags *diag.Diagnostics, state *tfsdk.State) {
+	diags.Append(state.Set(ctx, &struct {
+		ID           types.String                  `tfsdk:"id"`
+		Type         types.String                  `tfsdk:"type"`
+		Name         types.String                  `tfsdk:"name"`
+		Description  types.String                  `tfsdk:"description"`
+		Enabled      types.Bool                    `tfsdk:"enabled"`
+		Scope        *sharedModels.RootFilterModel `tfsdk:"scope"`
+		EmailConfig  *EmailConfigIssuesModel       `tfsdk:"email_config"`
+		SyslogConfig *SyslogConfigIssuesModel      `tfsdk:"syslog_config"`
+		Timezone     types.String                  `tfsdk:"timezone"`
+	}{
+		ID:           m.Shared.ID,
+		Type:         m.ConfigType,
+		Name:         m.Shared.Name,
+		Description:  m.Shared.Description,
+		Enabled:      m.Shared.Enabled,
+		Scope:        m.Shared.Scope,
+		Timezone:     m.Shared.Timezone,
+		EmailConfig:  m.EmailConfig,
+		SyslogConfig: m.SyslogConfig,
+	})...)
+}
+
+// ToCreateOrUpdateRequest returns a new CreateOrUpdateNotificationForwardingConfigurationRequest using the values of the NotificationForwardingConfigurationModel's fields.
+func (m *NotificationForwardingConfigurationModel) ToCreateOrUpdateRequest(ctx context.Context, diags *diag.Diagnostics) platformTypes.CreateOrUpdateNotificationForwardingConfigurationRequest {
+	// Convert scope filter
+	var scope filterTypes.FilterRoot
+	if m.Shared.Scope != nil {
+		scope = sharedModels.RootModelToSDKFilter(ctx, m.Shared.Scope)
+	}
+
+	// Convert integration forwarding configurations
+	forwardSource := platformTypes.ForwardSource{}
+	var (
+		emailFormat  string = EmailFormatIssue
+		syslogFormat string = EmailFormatIssue
+	)
+	if m.EmailConfig != nil {
+		var emailForwardSource platformTypes.EmailForwardSource
+		emailForwardSource, emailFormat = m.EmailConfig.ToSDK(ctx, diags)
+		if diags.HasError() {
+			return platformTypes.CreateOrUpdateNotificationForwardingConfigurationRequest{}
+		}
+		forwardSource.Email = &emailForwardSource
+	}
+	if m.SyslogConfig != nil {
+		var syslogForwardSource platformTypes.SyslogForwardSource
+		syslogForwardSource, syslogFormat = m.SyslogConfig.ToSDK(ctx, diags)
+		if diags.HasError() {
+			return platformTypes.CreateOrUpdateNotificationForwardingConfigurationRequest{}
+		}
+		forwardSource.Syslog = &syslogForwardSource
+	}
+
+	request := platformTypes.CreateOrUpdateNotificationForwardingConfigurationRequest{
+		Name:        m.Shared.Name.ValueString(),
+		Description: m.Shared.Description.ValueString(),
+		ForwardType: m.Type(),
+		Filter: struct {
+			Filter filterTypes.FilterRoot `json:"filter"`
+		}{
+			Filter: scope,
+		},
+		ForwardSource: forwardSource,
+	}
+
+	// Only issues support custom email and syslog formats
+	if m.ConfigType.ValueString() == NotificationForwardingConfigurationTypeIssues {
+		request.MailFormat = strings.ToLower(emailFormat)
+		request.SyslogFormat = strings.ToLower(syslogFormat)
+	}
+
+	return request
+}
+
+// Equals compares two NotificationForwardingConfigurationModel structs and
+// returns true if all of their fields are equal, false otherwise.
+func (m *NotificationForwardingConfigurationModel) Equals(diags *diag.Diagnostics, other *NotificationForwardingConfigurationModel) (isEqual bool) {
+	if m == nil && other == nil {
+		return true
+	}
+	if m == nil || other == nil {
+		return false
+	}
+
+	sharedFieldsEqual, err := m.Shared.Equals(&other.Shared)
+	if err != nil {
+		diags.AddError(
+			"Error Comparing Shared Notification Forwarding Configuration Fields",
+			fmt.Sprintf("Error occurred while comparing shared fields between model structs: %s"+
+				"\nPlease report this issue to the provider developers.", err.Error()),
+		)
+		return false
+	}
+
+	if !sharedFieldsEqual ||
+		!m.ConfigType.Equal(other.ConfigType) ||
+		!m.EmailConfig.Equals(other.EmailConfig) ||
+		!m.SyslogConfig.Equals(other.SyslogConfig) {
+		return false
+	}
+
+	return true
+}
+
+// RefreshFromRemote refreshes the model from the remote API response.
+func (m *NotificationForwardingConfigurationModel) RefreshFromRemote(ctx context.Context, diags *diag.Diagnostics, resp platformTypes.NotificationForwardingConfiguration) {
+	scope := sharedModels.SDKToModel(ctx, resp.Filter)
+	if len(scope.And) == 0 && len(scope.Or) == 0 {
+		scope = nil
+	}
+
+	configType := notificationForwardingConfigurationType(resp.ForwardType)
+
+	// Only issues support custom email and syslog formats
+	var (
+		emailFormat  = types.StringNull()
+		syslogFormat = types.StringNull()
+	)
+	if configType == NotificationForwardingConfigurationTypeIssues {
+		emailFormat = types.StringValue(strings.ToLower(resp.MailFormat))
+		syslogFormat = types.StringValue(strings.ToLower(resp.SyslogFormat))
+	}
+
+	if resp.ForwardSource != nil {
+		if resp.ForwardSource.Email != nil {
+			distributionList, convertDiags := types.ListValueFrom(ctx, types.StringType, resp.ForwardSource.Email.DistributionList)
+			diags.Append(convertDiags...)
+			if diags.HasError() {
+				return
+			}
+
+			m.EmailConfig = &EmailConfigIssuesModel{
+				GroupingTimeframe: types.Int32Value(int32(resp.ForwardSource.Email.Aggregation)),
+				DistributionList:  distributionList,
+				Subject:           types.StringValue(resp.ForwardSource.Email.CustomMailSubject),
+				Format:            emailFormat,
+			}
+		}
+
+		if resp.ForwardSource.Syslog != nil {
+			m.SyslogConfig = &SyslogConfigIssuesModel{
+				ServerID: types.Int64Value(int64(resp.ForwardSource.Syslog.ID)),
+				Format:   syslogFormat,
+			}
+		}
+	}
+
+	m.Shared.ID = types.StringValue(resp.ID)
+	m.Shared.Name = types.StringValue(resp.Name)
+	m.Shared.Description = types.StringValue(resp.Description)
+	m.Shared.Enabled = types.BoolValue(resp.Enabled)
+	m.Shared.Scope = scope
+	m.Shared.Timezone = types.StringValue(resp.TimeZone)
+	m.ConfigType = types.StringValue(configType)
+}
+
+// ToNotificationForwardingConfigurationModel converts the model to the model
+// of the generic notification_forwarding_config resource.
+func (m *NotificationForwardingConfigurationAgentAuditLogsModel) ToNotificationForwardingConfigurationModel() NotificationForwardingConfigurationModel {
+	return NotificationForwardingConfigurationModel{
+		Shared:       m.Shared,
+		ConfigType:   types.StringValue(NotificationForwardingConfigurationTypeAgentAuditLogs),
+		EmailConfig:  m.EmailConfig.toIssuesModel(),
+		SyslogConfig: m.SyslogConfig.toIssuesModel(),
+	}
+}
+
+// ToNotificationForwardingConfigurationModel converts the model to the model
+// of the generic notification_forwarding_config resource.
+func (m *NotificationForwardingConfigurationMgmtAuditLogsModel) ToNotificationForwardingConfigurationModel() NotificationForwardingConfigurationModel {
+	return NotificationForwardingConfigurationModel{
+		Shared:       m.Shared,
+		ConfigType:   types.StringValue(NotificationForwardingConfigurationTypeMgmtAuditLogs),
+		EmailConfig:  m.EmailConfig.toIssuesModel(),
+		SyslogConfig: m.SyslogConfig.toIssuesModel(),
+	}
+}
+
+// ToNotificationForwardingConfigurationModel converts the model to the model
+// of the generic notification_forwarding_config resource.
+func (m *NotificationForwardingConfigurationIssuesModel) ToNotificationForwardingConfigurationModel() NotificationForwardingConfigurationModel {
+	return NotificationForwardingConfigurationModel{
+		Shared:       m.Shared,
+		ConfigType:   types.StringValue(NotificationForwardingConfigurationTypeIssues),
+		EmailConfig:  m.EmailConfig,
+		SyslogConfig: m.SyslogConfig,
+	}
+}
+
+// ToNotificationForwardingConfigurationModel converts the model to the model
+// of the generic notification_forwarding_config resource.
+func (m *NotificationForwardingConfigurationCasesModel) ToNotificationForwardingConfigurationModel() NotificationForwardingConfigurationModel {
+	return NotificationForwardingConfigurationModel{
+		Shared:      m.Shared,
+		ConfigType:  types.StringValue(NotificationForwardingConfigurationTypeCases),
+		EmailConfig: m.EmailConfig.toIssuesModel(),
+	}
+}
+
+// toIssuesModel converts the email settings to the Issues representation,
+// without a format.
+func (m *EmailConfigModel) toIssuesModel() *EmailConfigIssuesModel {
+	if m == nil {
+		return nil
+	}
+
+	return &EmailConfigIssuesModel{
+		DistributionList:  m.DistributionList,
+		Subject:           m.Subject,
+		Format:            types.StringNull(),
+		GroupingTimeframe: m.GroupingTimeframe,
+	}
+}
+
+// toIssuesModel converts the syslog settings to the Issues representation,
+// without a format.
+func (m *SyslogConfigModel) toIssuesModel() *SyslogConfigIssuesModel {
+	if m == nil {
+		return nil
+	}
+
+	return &SyslogConfigIssuesModel{
+		ServerID: m.ServerID,
+		Format:   types.StringNull(),
+	}
+}
+
+// notificationForwardingConfigurationType returns the configuration type
+// corresponding to the given forward type. Forward types without a
+// corresponding configuration type are returned as-is.
+func notificationForwardingConfigurationType(forwardType string) string {
+	if configType, ok := notificationForwardingConfigurationTypes[forwardType]; ok {
+		return configType
+	}
+
+	return forwardType
+}
+
 // ----------------------------------------------------------------------------
 // Validation
 // ----------------------------------------------------------------------------
+
+// validateForwardingDestinations adds an error to the diagnostics if none of
+// the given forwarding destination attributes have been configured. The
+// destinations map is keyed by attribute name, with each value indicating
+// whether that attribute is configured.
+func validateForwardingDestinations(diags *diag.Diagnostics, destinations map[string]bool) {
+	attributeNames := make([]string, 0, len(destinations))
+	for attributeName, isConfigured := range destinations {
+		if isConfigured {
+			return
+		}
+		attributeNames = append(attributeNames, attributeName)
+	}
+	sort.Strings(attributeNames)
+
+	diags.AddError(
+		"Invalid Resource Configuration",
+		fmt.Sprintf("At least one of the following attributes must be configured: %s", strings.Join(attributeNames, ", ")),
+	)
+}
@@ -0,0 +1,225 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	sharedModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/shared"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Search types supported by the scope of notification forwarding
+// configurations, grouped by the kind of value of the search field.
+var (
+	notificationScopeTextSearchTypes    = []string{"EQ", "NEQ", "CONTAINS", "NCONTAINS", "WILDCARD"}
+	notificationScopeEnumSearchTypes    = []string{"EQ", "NEQ", "IN", "NIN"}
+	notificationScopeNumberSearchTypes  = []string{"EQ", "NEQ", "GT", "GTE", "LT", "LTE"}
+	notificationScopeBooleanSearchTypes = []string{"EQ"}
+	notificationScopeArraySearchTypes   = []string{"ARRAY_CONTAINS"}
+)
+
+// notificationScopeAuditLogSearchFields contains the search fields shared by
+// the agent and management audit log configurations.
+var notificationScopeAuditLogSearchFields = map[string][]string{
+	"CATEGORY":    notificationScopeEnumSearchTypes,
+	"DESCRIPTION": notificationScopeTextSearchTypes,
+	"RESULT":      notificationScopeEnumSearchTypes,
+	"SEVERITY":    notificationScopeEnumSearchTypes,
+	"SUB_TYPE":    notificationScopeEnumSearchTypes,
+	"TYPE":        notificationScopeEnumSearchTypes,
+}
+
+// NotificationForwardingScopeSearchFields maps each notification forwarding
+// configuration type to the search fields that may be used in its scope, and
+// the search types supported by each field. The search types of each field
+// are those of the kind of value it holds (text, enumeration, number,
+// boolean or array).
+//
+// Only the fields listed in notificationScopeVerifiedSearchFields have been
+// verified against the API, so search fields and search types that are not
+// listed here produce a warning rather than an error, unless the search
+// field has been verified.
+var NotificationForwardingScopeSearchFields = map[string]map[string][]string{
+	NotificationForwardingConfigurationTypeAgentAuditLogs: withSearchFields(notificationScopeAuditLogSearchFields, map[string][]string{
+		"DOMAIN":        notificationScopeTextSearchTypes,
+		"ENDPOINT_ID":   notificationScopeEnumSearchTypes,
+		"ENDPOINT_NAME": notificationScopeTextSearchTypes,
+		"REASON":        notificationScopeTextSearchTypes,
+	}),
+	NotificationForwardingConfigurationTypeMgmtAuditLogs: withSearchFields(notificationScopeAuditLogSearchFields, map[string][]string{
+		"EMAIL":     notificationScopeTextSearchTypes,
+		"REASON":    notificationScopeTextSearchTypes,
+		"USER_NAME": notificationScopeTextSearchTypes,
+	}),
+	NotificationForwardingConfigurationTypeCases: {
+		"ASSIGNED_USER":   notificationScopeTextSearchTypes,
+		"CASE_ID":         notificationScopeNumberSearchTypes,
+		"CASE_NAME":       notificationScopeTextSearchTypes,
+		"DESCRIPTION":     notificationScopeTextSearchTypes,
+		"HOSTS":           notificationScopeArraySearchTypes,
+		"SEVERITY":        notificationScopeEnumSearchTypes,
+		"STARRED":         notificationScopeBooleanSearchTypes,
+		"STATUS_PROGRESS": notificationScopeEnumSearchTypes,
+		"TAGS":            notificationScopeArraySearchTypes,
+		"USERS":           notificationScopeArraySearchTypes,
+	},
+	NotificationForwardingConfigurationTypeIssues: {
+		"ALERT_ID":       notificationScopeNumberSearchTypes,
+		"ALERT_NAME":     notificationScopeTextSearchTypes,
+		"ALERT_SOURCE":   notificationScopeEnumSearchTypes,
+		"CATEGORY":       notificationScopeEnumSearchTypes,
+		"DESCRIPTION":    notificationScopeTextSearchTypes,
+		"DOMAIN":         notificationScopeEnumSearchTypes,
+		"HOST_NAME":      notificationScopeTextSearchTypes,
+		"IS_WHITELISTED": notificationScopeBooleanSearchTypes,
+		"MITRE_TACTIC":   notificationScopeArraySearchTypes,
+		"SEVERITY":       notificationScopeEnumSearchTypes,
+		"STARRED":        notificationScopeBooleanSearchTypes,
+		"TAGS":           notificationScopeArraySearchTypes,
+		"USER_NAME":      notificationScopeTextSearchTypes,
+	},
+}
+
+// notificationScopeVerifiedSearchFields maps each notification forwarding
+// configuration type to the search fields of its scope that are exercised
+// against the API by the acceptance tests. Search types that are not listed
+// in NotificationForwardingScopeSearchFields for these fields are rejected.
+var notificationScopeVerifiedSearchFields = map[string][]string{
+	NotificationForwardingConfigurationTypeAgentAuditLogs: {"CATEGORY", "SEVERITY", "TYPE"},
+	NotificationForwardingConfigurationTypeMgmtAuditLogs:  {"CATEGORY", "SEVERITY", "TYPE"},
+	NotificationForwardingConfigurationTypeCases:          {"CASE_ID", "SEVERITY", "STATUS_PROGRESS"},
+	NotificationForwardingConfigurationTypeIssues:         {"ALERT_NAME", "IS_WHITELISTED", "STARRED"},
+}
+
+// withSearchFields returns a new map containing the search fields of both
+// of the given maps.
+func withSearchFields(base, additional map[string][]string) map[string][]string {
+	fields := make(map[string][]string, len(base)+len(additional))
+	for field, searchTypes := range base {
+		fields[field] = searchTypes
+	}
+	for field, searchTypes := range additional {
+		fields[field] = searchTypes
+	}
+
+	return fields
+}
+
+// NotificationForwardingScopeDescription returns the description of the
+// scope attribute of configurations of the given type, listing the
+// supported search fields and the search types supported by each field. If
+// no type is given, the search fields supported by each type are listed.
+func NotificationForwardingScopeDescription(configType string) string {
+	description := "The filter for the configuration."
+	if configType != "" {
+		return fmt.Sprintf("%s Supported search fields and their search types are: %s. Other search fields and search types produce a warning, except that other search types of the %s search fields are rejected.", description, notificationForwardingScopeFieldsDescription(configType), notificationForwardingVerifiedFieldsDescription(configType))
+	}
+
+	for _, configType := range NotificationForwardingConfigurationTypeEnums {
+		description += fmt.Sprintf(" When `type` is \"%s\", supported search fields and their search types are: %s. Other search types of the %s search fields are rejected.", configType, notificationForwardingScopeFieldsDescription(configType), notificationForwardingVerifiedFieldsDescription(configType))
+	}
+
+	return description + " Other search fields and search types produce a warning."
+}
+
+// notificationForwardingScopeFieldsDescription returns a comma-separated
+// list of the search fields supported by configurations of the given type,
+// each followed by its supported search types.
+func notificationForwardingScopeFieldsDescription(configType string) string {
+	searchFields := NotificationForwardingScopeSearchFields[configType]
+	fields := make([]string, 0, len(searchFields))
+	for field, searchTypes := range searchFields {
+		fields = append(fields, fmt.Sprintf("\"%s\" (\"%s\")", field, strings.Join(searchTypes, `", "`)))
+	}
+	slices.Sort(fields)
+
+	return strings.Join(fields, ", ")
+}
+
+// notificationForwardingVerifiedFieldsDescription returns the search fields
+// of configurations of the given type that have been verified against the
+// API, as a quoted list.
+func notificationForwardingVerifiedFieldsDescription(configType string) string {
+	fields := notificationScopeVerifiedSearchFields[configType]
+
+	return fmt.Sprintf("\"%s\" and \"%s\"", strings.Join(fields[:len(fields)-1], `", "`), fields[len(fields)-1])
+}
+
+// validateNotificationForwardingScope checks the structure of the scope of a
+// configuration of the given type, and adds a diagnostic suggesting the
+// closest supported value for each search condition that uses a search
+// field or search type not listed for that type. Search types that are not
+// supported by a verified search field are errors. Unknown search fields and
+// the search types of unverified fields only produce warnings, since the API
+// may support values that are not yet listed in
+// NotificationForwardingScopeSearchFields.
+func validateNotificationForwardingScope(diags *diag.Diagnostics, configType string, scope *sharedModels.RootFilterModel) {
+	searchFields, ok := NotificationForwardingScopeSearchFields[configType]
+	if !ok {
+		return
+	}
+
+	scope.Validate(path.Root("scope"), diags, func(attrPath path.Path, filter sharedModels.NestedFilterModel, diags *diag.Diagnostics) {
+		if filter.SearchField.IsUnknown() {
+			return
+		}
+
+		searchField := filter.SearchField.ValueString()
+		searchTypes, ok := searchFields[searchField]
+		if !ok {
+			supportedFields := make([]string, 0, len(searchFields))
+			for field := range searchFields {
+				supportedFields = append(supportedFields, field)
+			}
+			slices.Sort(supportedFields)
+
+			diags.AddAttributeWarning(
+				attrPath.AtName("search_field"),
+				"Unknown Search Field",
+				fmt.Sprintf("Search field %s is not a known search field of %s notification forwarding configurations, and may be rejected by the Cortex Cloud API.%s Known search fields are: \"%s\".", strconv.Quote(searchField), configType, didYouMean(searchField, supportedFields), strings.Join(supportedFields, `", "`)),
+			)
+			return
+		}
+
+		if filter.SearchType.IsUnknown() {
+			return
+		}
+
+		searchType := filter.SearchType.ValueString()
+		if slices.Contains(searchTypes, searchType) {
+			return
+		}
+
+		if slices.Contains(notificationScopeVerifiedSearchFields[configType], searchField) {
+			diags.AddAttributeError(
+				attrPath.AtName("search_type"),
+				"Unsupported Search Type",
+				fmt.Sprintf("Search type %s is not supported by search field %s.%s Supported search types are: \"%s\".", strconv.Quote(searchType), strconv.Quote(searchField), didYouMean(searchType, searchTypes), strings.Join(searchTypes, `", "`)),
+			)
+		} else {
+			diags.AddAttributeWarning(
+				attrPath.AtName("search_type"),
+				"Unknown Search Type",
+				fmt.Sprintf("Search type %s is not a known search type of search field %s, and may be rejected by the Cortex Cloud API.%s Known search types are: \"%s\".", strconv.Quote(searchType), strconv.Quote(searchField), didYouMean(searchType, searchTypes), strings.Join(searchTypes, `", "`)),
+			)
+		}
+	})
+}
+
+// didYouMean returns a sentence suggesting the candidate closest to value,
+// or an empty string if none of the candidates are similar.
+func didYouMean(value string, candidates []string) string {
+	if match, ok := util.ClosestMatch(value, candidates); ok {
+		return fmt.Sprintf(" Did you mean %s?", strconv.Quote(match))
+	}
+
+	return ""
+}
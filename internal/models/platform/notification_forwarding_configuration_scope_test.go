@@ -0,0 +1,125 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"testing"
+
+	sharedModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testScopeFilter(field, searchType, value string) sharedModels.NestedFilterModel {
+	return sharedModels.NestedFilterModel{
+		SearchField: types.StringValue(field),
+		SearchType:  types.StringValue(searchType),
+		SearchValue: types.StringValue(value),
+	}
+}
+
+func TestValidateNotificationForwardingScope(t *testing.T) {
+	testCases := []struct {
+		name         string
+		configType   string
+		filter       sharedModels.NestedFilterModel
+		errorCount   int
+		warningCount int
+	}{
+		{
+			name:       "valid audit log filter",
+			configType: NotificationForwardingConfigurationTypeAgentAuditLogs,
+			filter:     testScopeFilter("CATEGORY", "NEQ", "Audit"),
+		},
+		{
+			name:       "valid issues filter",
+			configType: NotificationForwardingConfigurationTypeIssues,
+			filter:     testScopeFilter("ALERT_NAME", "CONTAINS", "malware"),
+		},
+		{
+			name:       "unknown values",
+			configType: NotificationForwardingConfigurationTypeCases,
+			filter: sharedModels.NestedFilterModel{
+				SearchField: types.StringUnknown(),
+				SearchType:  types.StringUnknown(),
+				SearchValue: types.StringValue("SEV_050_CRITICAL"),
+			},
+		},
+		{
+			name:         "field not supported by type",
+			configType:   NotificationForwardingConfigurationTypeCases,
+			filter:       testScopeFilter("IS_WHITELISTED", "EQ", "true"),
+			warningCount: 1,
+		},
+		{
+			name:         "search type not supported by unverified field",
+			configType:   NotificationForwardingConfigurationTypeIssues,
+			filter:       testScopeFilter("SEVERITY", "CONTAINS", "HIGH"),
+			warningCount: 1,
+		},
+		{
+			name:       "search type not supported by verified field",
+			configType: NotificationForwardingConfigurationTypeIssues,
+			filter:     testScopeFilter("ALERT_NAME", "IN", "malware"),
+			errorCount: 1,
+		},
+		{
+			name:       "missing search value",
+			configType: NotificationForwardingConfigurationTypeIssues,
+			filter: sharedModels.NestedFilterModel{
+				SearchField: types.StringValue("SEVERITY"),
+				SearchType:  types.StringValue("EQ"),
+				SearchValue: types.StringNull(),
+			},
+			errorCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateNotificationForwardingScope(&diags, tc.configType, &sharedModels.RootFilterModel{
+				And: []sharedModels.NestedFilterModel{tc.filter},
+			})
+			assert.Equal(t, tc.errorCount, diags.ErrorsCount(), "unexpected diagnostics: %v", diags)
+			assert.Equal(t, tc.warningCount, diags.WarningsCount(), "unexpected diagnostics: %v", diags)
+		})
+	}
+}
+
+func TestValidateNotificationForwardingScope_Suggestions(t *testing.T) {
+	var diags diag.Diagnostics
+	validateNotificationForwardingScope(&diags, NotificationForwardingConfigurationTypeIssues, &sharedModels.RootFilterModel{
+		Or: []sharedModels.NestedFilterModel{
+			testScopeFilter("DOMIAN", "EQ", "DOMAIN_SECURITY"),
+			testScopeFilter("ALERT_NAME", "CONTAIN", "malware"),
+		},
+	})
+
+	require.Equal(t, 1, diags.WarningsCount(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, 1, diags.ErrorsCount(), "unexpected diagnostics: %v", diags)
+
+	fieldWarning := diags.Warnings()[0]
+	assert.Equal(t, path.Root("scope").AtName("or").AtListIndex(0).AtName("search_field"), fieldWarning.(diag.DiagnosticWithPath).Path())
+	assert.Contains(t, fieldWarning.Detail(), `Did you mean "DOMAIN"?`)
+
+	typeErr := diags.Errors()[0]
+	assert.Equal(t, path.Root("scope").AtName("or").AtListIndex(1).AtName("search_type"), typeErr.(diag.DiagnosticWithPath).Path())
+	assert.Contains(t, typeErr.Detail(), `Did you mean "CONTAINS"?`)
+}
+
+func TestNotificationForwardingScopeDescription(t *testing.T) {
+	description := NotificationForwardingScopeDescription(NotificationForwardingConfigurationTypeCases)
+	assert.Contains(t, description, `"CASE_ID" ("EQ", "NEQ", "GT", "GTE", "LT", "LTE")`)
+	assert.NotContains(t, description, `"IS_WHITELISTED"`)
+	assert.Contains(t, description, `other search types of the "CASE_ID", "SEVERITY" and "STATUS_PROGRESS" search fields are rejected`)
+
+	description = NotificationForwardingScopeDescription("")
+	for _, configType := range NotificationForwardingConfigurationTypeEnums {
+		assert.Contains(t, description, "When `type` is \""+configType+"\"")
+	}
+}
@@ -314,3 +314,45 @@ func nilIfEmpty(s string) *string {
 	}
 	return &s
 }
+
+// ScopesDataSourceModel is the model for the scopes data source.
+type ScopesDataSourceModel struct {
+	ID         types.String                 `tfsdk:"id"`
+	EntityType types.String                 `tfsdk:"entity_type"`
+	Scopes     []ScopesDataSourceScopeModel `tfsdk:"scopes"`
+}
+
+// ScopesDataSourceScopeModel is the model for each scope returned by the
+// scopes data source.
+type ScopesDataSourceScopeModel struct {
+	ID           types.String       `tfsdk:"id"`
+	EntityType   types.String       `tfsdk:"entity_type"`
+	EntityID     types.String       `tfsdk:"entity_id"`
+	EntityName   types.String       `tfsdk:"entity_name"`
+	Assets       *AssetsModel       `tfsdk:"assets"`
+	DatasetsRows *DatasetsRowsModel `tfsdk:"datasets_rows"`
+	Endpoints    *EndpointsModel    `tfsdk:"endpoints"`
+	CasesIssues  *CasesIssuesModel  `tfsdk:"cases_issues"`
+}
+
+// RefreshFromRemote populates the model from the SDK's Scope object for the
+// given entity.
+func (m *ScopesDataSourceScopeModel) RefreshFromRemote(ctx context.Context, diags *diag.Diagnostics, entityType, entityID, entityName string, remote *platformtypes.Scope) {
+	scope := ScopeModel{
+		EntityType: types.StringValue(entityType),
+		EntityID:   types.StringValue(entityID),
+	}
+	scope.RefreshFromRemote(ctx, diags, remote)
+	if diags.HasError() {
+		return
+	}
+
+	m.ID = types.StringValue(entityType + ":" + entityID)
+	m.EntityType = scope.EntityType
+	m.EntityID = scope.EntityID
+	m.EntityName = types.StringValue(entityName)
+	m.Assets = scope.Assets
+	m.DatasetsRows = scope.DatasetsRows
+	m.Endpoints = scope.Endpoints
+	m.CasesIssues = scope.CasesIssues
+}
@@ -5,6 +5,8 @@ package models
 
 import (
 	"context"
+	"regexp"
+	"strings"
 
 	platformtypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/platform"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -148,3 +150,106 @@ func (m *UserGroupModel) RefreshFromRemote(ctx context.Context, diagnostics *dia
 		}
 	}
 }
+
+// UserGroupsDataSourceModel is the model for the user_groups data source.
+type UserGroupsDataSourceModel struct {
+	ID          types.String                         `tfsdk:"id"`
+	RoleID      types.String                         `tfsdk:"role_id"`
+	GroupType   types.String                         `tfsdk:"group_type"`
+	UserEmail   types.String                         `tfsdk:"user_email"`
+	NamePattern types.String                         `tfsdk:"name_pattern"`
+	UserGroups  []UserGroupsDataSourceUserGroupModel `tfsdk:"user_groups"`
+}
+
+// UserGroupsDataSourceUserGroupModel is the model for each user group
+// returned by the user_groups data source.
+type UserGroupsDataSourceUserGroupModel struct {
+	ID             types.String       `tfsdk:"id"`
+	GroupName      types.String       `tfsdk:"group_name"`
+	Description    types.String       `tfsdk:"description"`
+	RoleID         types.String       `tfsdk:"role_id"`
+	PrettyRoleName types.String       `tfsdk:"pretty_role_name"`
+	CreatedBy      types.String       `tfsdk:"created_by"`
+	CreatedTS      types.Int64        `tfsdk:"created_ts"`
+	UpdatedTS      types.Int64        `tfsdk:"updated_ts"`
+	AllUsers       types.Set          `tfsdk:"all_users"`
+	GroupType      types.String       `tfsdk:"group_type"`
+	NestedGroups   []NestedGroupModel `tfsdk:"nested_groups"`
+	IDPGroups      types.Set          `tfsdk:"idp_groups"`
+}
+
+// Matches returns whether the given user group satisfies all of the
+// filters configured in the data source model. Group type and user email
+// are compared case-insensitively.
+func (m *UserGroupsDataSourceModel) Matches(remote platformtypes.UserGroup, namePattern *regexp.Regexp) bool {
+	if !m.RoleID.IsNull() && remote.RoleName != m.RoleID.ValueString() {
+		return false
+	}
+	if !m.GroupType.IsNull() && !strings.EqualFold(remote.GroupType, m.GroupType.ValueString()) {
+		return false
+	}
+	if namePattern != nil && !namePattern.MatchString(remote.GroupName) {
+		return false
+	}
+
+	if !m.UserEmail.IsNull() {
+		isMember := false
+		for _, user := range remote.Users {
+			if strings.EqualFold(user, m.UserEmail.ValueString()) {
+				isMember = true
+				break
+			}
+		}
+		if !isMember {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RefreshFromRemote populates the model with the user groups matching the
+// configured filters.
+func (m *UserGroupsDataSourceModel) RefreshFromRemote(ctx context.Context, diagnostics *diag.Diagnostics, remote []platformtypes.UserGroup) {
+	var namePattern *regexp.Regexp
+	if !m.NamePattern.IsNull() {
+		var err error
+		namePattern, err = regexp.Compile(m.NamePattern.ValueString())
+		if err != nil {
+			diagnostics.AddError("Invalid Name Pattern", err.Error())
+			return
+		}
+	}
+
+	m.ID = types.StringValue("user_groups")
+
+	m.UserGroups = []UserGroupsDataSourceUserGroupModel{}
+	for i := range remote {
+		if !m.Matches(remote[i], namePattern) {
+			continue
+		}
+
+		group := UserGroupModel{
+			Users: types.SetNull(types.StringType),
+		}
+		group.RefreshFromRemote(ctx, diagnostics, &remote[i])
+		if diagnostics.HasError() {
+			return
+		}
+
+		m.UserGroups = append(m.UserGroups, UserGroupsDataSourceUserGroupModel{
+			ID:             group.ID,
+			GroupName:      group.GroupName,
+			Description:    group.Description,
+			RoleID:         group.RoleID,
+			PrettyRoleName: group.PrettyRoleName,
+			CreatedBy:      group.CreatedBy,
+			CreatedTS:      group.CreatedTS,
+			UpdatedTS:      group.UpdatedTS,
+			AllUsers:       group.AllUsers,
+			GroupType:      group.GroupType,
+			NestedGroups:   group.NestedGroups,
+			IDPGroups:      group.IDPGroups,
+		})
+	}
+}
@@ -5,6 +5,8 @@ package models
 
 import (
 	"context"
+	"regexp"
+	"strings"
 
 	platformtypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/platform"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -99,3 +101,106 @@ func (m *UserModel) RefreshFromRemote(ctx context.Context, diags *diag.Diagnosti
 	m.Groups = lv
 
 }
+
+// UsersDataSourceModel is the model for the users data source.
+type UsersDataSourceModel struct {
+	ID           types.String               `tfsdk:"id"`
+	RoleName     types.String               `tfsdk:"role_name"`
+	Status       types.String               `tfsdk:"status"`
+	UserType     types.String               `tfsdk:"user_type"`
+	GroupID      types.String               `tfsdk:"group_id"`
+	GroupName    types.String               `tfsdk:"group_name"`
+	EmailPattern types.String               `tfsdk:"email_pattern"`
+	Users        []UsersDataSourceUserModel `tfsdk:"users"`
+}
+
+// UsersDataSourceUserModel is the model for each user returned by the
+// users data source.
+type UsersDataSourceUserModel struct {
+	Email        types.String `tfsdk:"user_email"`
+	FirstName    types.String `tfsdk:"user_first_name"`
+	LastName     types.String `tfsdk:"user_last_name"`
+	PhoneNumber  types.String `tfsdk:"phone_number"`
+	Status       types.String `tfsdk:"status"`
+	RoleName     types.String `tfsdk:"role_name"`
+	LastLoggedIn types.Int64  `tfsdk:"last_logged_in"`
+	Hidden       types.Bool   `tfsdk:"hidden"`
+	UserType     types.String `tfsdk:"user_type"`
+	Groups       types.List   `tfsdk:"groups"`
+}
+
+// Matches returns whether the given user satisfies all of the filters
+// configured in the data source model. Role name, status and user type are
+// compared case-insensitively.
+func (m *UsersDataSourceModel) Matches(remote platformtypes.IamUser, emailPattern *regexp.Regexp) bool {
+	if !m.RoleName.IsNull() && !strings.EqualFold(remote.RoleName, m.RoleName.ValueString()) {
+		return false
+	}
+	if !m.Status.IsNull() && !strings.EqualFold(remote.Status, m.Status.ValueString()) {
+		return false
+	}
+	if !m.UserType.IsNull() && !strings.EqualFold(remote.UserType, m.UserType.ValueString()) {
+		return false
+	}
+	if emailPattern != nil && !emailPattern.MatchString(remote.Email) {
+		return false
+	}
+
+	if !m.GroupID.IsNull() || !m.GroupName.IsNull() {
+		isMember := false
+		for _, group := range remote.Groups {
+			if (m.GroupID.IsNull() || group.GroupID == m.GroupID.ValueString()) &&
+				(m.GroupName.IsNull() || group.GroupName == m.GroupName.ValueString()) {
+				isMember = true
+				break
+			}
+		}
+		if !isMember {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RefreshFromRemote populates the model with the users matching the
+// configured filters.
+func (m *UsersDataSourceModel) RefreshFromRemote(ctx context.Context, diags *diag.Diagnostics, remote []platformtypes.IamUser) {
+	var emailPattern *regexp.Regexp
+	if !m.EmailPattern.IsNull() {
+		var err error
+		emailPattern, err = regexp.Compile(m.EmailPattern.ValueString())
+		if err != nil {
+			diags.AddError("Invalid Email Pattern", err.Error())
+			return
+		}
+	}
+
+	m.ID = types.StringValue("users")
+
+	m.Users = []UsersDataSourceUserModel{}
+	for i := range remote {
+		if !m.Matches(remote[i], emailPattern) {
+			continue
+		}
+
+		var user UserModel
+		user.RefreshFromRemote(ctx, diags, &remote[i])
+		if diags.HasError() {
+			return
+		}
+
+		m.Users = append(m.Users, UsersDataSourceUserModel{
+			Email:        user.Email,
+			FirstName:    user.FirstName,
+			LastName:     user.LastName,
+			PhoneNumber:  user.PhoneNumber,
+			Status:       user.Status,
+			RoleName:     user.RoleName,
+			LastLoggedIn: user.LastLoggedIn,
+			Hidden:       user.Hidden,
+			UserType:     user.UserType,
+			Groups:       user.Groups,
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// credentialProcessOutput is the JSON object that the credential process is
+// expected to write to stdout.
+type credentialProcessOutput struct {
+	APIURL     string     `json:"api_url"`
+	APIKey     string     `json:"api_key"`
+	APIKeyID   int32      `json:"api_key_id"`
+	APIKeyType string     `json:"api_key_type"`
+	Expiration *time.Time `json:"expiration"`
+}
+
+// expired returns true if the credentials have expired. Credentials without
+// an expiration never expire.
+func (o credentialProcessOutput) expired() bool {
+	return o.Expiration != nil && time.Now().After(*o.Expiration)
+}
+
+// credentialProcessCache holds the output of each credential process command
+// that has been run by the current provider process, so that provider
+// configurations sharing the same command (e.g. aliased providers) only run
+// it once. Credentials are kept in memory and never written to disk.
+var credentialProcessCache = struct {
+	sync.Mutex
+	outputs map[string]credentialProcessOutput
+}{
+	outputs: map[string]credentialProcessOutput{},
+}
+
+// ParseCredentialProcess runs the command specified in the provider block's
+// `credential_process` argument and overwrites the provider configuration
+// values with the non-empty values written to its stdout.
+//
+// The output is cached until its expiration time, or for the lifetime of
+// the provider process if no expiration is returned. The expiration is only
+// checked when the provider is configured: the credentials are built into
+// the SDK clients, so they are not refreshed if they expire during a run.
+func (m *CortexCloudProviderModel) ParseCredentialProcess(ctx context.Context, diagnostics *diag.Diagnostics) {
+	if m.CredentialProcess.IsNull() || m.CredentialProcess.IsUnknown() || m.CredentialProcess.ValueString() == "" {
+		tflog.Debug(ctx, "No credential process specified -- Skipping execution.")
+		return
+	}
+
+	command := m.CredentialProcess.ValueString()
+
+	credentialProcessCache.Lock()
+	defer credentialProcessCache.Unlock()
+
+	output, ok := credentialProcessCache.outputs[command]
+	if ok && !output.expired() {
+		tflog.Debug(ctx, "Using cached credential process output")
+	} else {
+		tflog.Debug(ctx, "Running credential process")
+
+		var err error
+		output, err = runCredentialProcess(ctx, command)
+		if err != nil {
+			diagnostics.AddAttributeError(
+				path.Root("credential_process"),
+				"Provider Configuration Error",
+				fmt.Sprintf("Error occured running credential process: %s", err.Error()),
+			)
+			return
+		}
+
+		credentialProcessCache.outputs[command] = output
+	}
+
+	// The key itself is never logged
+	if output.APIURL != "" {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting api_url from credential process: "%s" => "%s"`, m.APIURL.ValueString(), output.APIURL))
+		m.APIURL = types.StringValue(output.APIURL)
+	}
+	tflog.Debug(ctx, "Overwriting api_key from credential process")
+	m.APIKey = types.StringValue(output.APIKey)
+	if output.APIKeyID != 0 {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting api_key_id from credential process: "%d" => "%d"`, m.APIKeyID.ValueInt32(), output.APIKeyID))
+		m.APIKeyID = types.Int32Value(output.APIKeyID)
+	}
+	if output.APIKeyType != "" {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting api_key_type from credential process: "%s" => "%s"`, m.APIKeyType.ValueString(), output.APIKeyType))
+		m.APIKeyType = types.StringValue(output.APIKeyType)
+	}
+}
+
+// runCredentialProcess runs the given command using the system shell and
+// parses its stdout. Anything the command writes to stderr is included in
+// the returned error if the command fails.
+func runCredentialProcess(ctx context.Context, command string) (credentialProcessOutput, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return credentialProcessOutput{}, fmt.Errorf("%w: %s", err, msg)
+		}
+		return credentialProcessOutput{}, err
+	}
+
+	var output credentialProcessOutput
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return credentialProcessOutput{}, fmt.Errorf("error parsing output as JSON: %w", err)
+	}
+
+	if output.APIKey == "" {
+		return credentialProcessOutput{}, errors.New(`output does not contain an "api_key" value`)
+	}
+	if output.expired() {
+		return credentialProcessOutput{}, fmt.Errorf("returned credentials expired at %s", output.Expiration.Format(time.RFC3339))
+	}
+
+	return output, nil
+}
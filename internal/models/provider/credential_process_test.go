@@ -0,0 +1,224 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createCredentialHelper is a helper function to create a fake credential
+// helper script that writes the given output to stdout and appends a line to
+// a counter file each time it is run. It returns the command to run the
+// script and the path of the counter file.
+func createCredentialHelper(t *testing.T, output string, exitCode int) (string, string) {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("credential helper script requires a POSIX shell")
+	}
+
+	dir := t.TempDir()
+	counterFile := filepath.Join(dir, "count")
+	script := filepath.Join(dir, "helper.sh")
+
+	content := fmt.Sprintf("#!/bin/sh\necho run >> '%s'\ncat <<'EOF'\n%s\nEOF\n", counterFile, output)
+	if exitCode != 0 {
+		content += fmt.Sprintf("echo 'helper failed' >&2\nexit %d\n", exitCode)
+	}
+
+	if err := os.WriteFile(script, []byte(content), 0o700); err != nil {
+		t.Fatalf("Failed to write credential helper script: %v", err)
+	}
+
+	return script, counterFile
+}
+
+// credentialHelperRuns returns the number of times the credential helper
+// created by createCredentialHelper has been run
+func credentialHelperRuns(t *testing.T, counterFile string) int {
+	t.Helper()
+
+	data, err := os.ReadFile(counterFile)
+	if os.IsNotExist(err) {
+		return 0
+	}
+	require.NoError(t, err)
+
+	return strings.Count(string(data), "run")
+}
+
+// TestCredentialProcess verifies that the values written to stdout by the
+// credential process overwrite the provider configuration values
+func TestCredentialProcess(t *testing.T) {
+	command, counterFile := createCredentialHelper(t, `{
+		"api_url": "https://api-credential.process",
+		"api_key": "key-from-credential-process",
+		"api_key_id": 42
+	}`, 0)
+
+	var (
+		ctx   = context.Background()
+		diags diag.Diagnostics
+		model = CortexCloudProviderModel{
+			APIURL:            types.StringValue("https://api-provider.block"),
+			APIKey:            types.StringValue("key-from-provider-block"),
+			APIKeyType:        types.StringValue(testAPIKeyType),
+			CredentialProcess: types.StringValue(command),
+		}
+	)
+
+	model.ParseCredentialProcess(ctx, &diags)
+	require.False(t, diags.HasError(), "ParseCredentialProcess produced diagnostics: %v", diags.Errors())
+
+	assert.Equal(t, "https://api-credential.process", model.APIURL.ValueString())
+	assert.Equal(t, "key-from-credential-process", model.APIKey.ValueString())
+	assert.Equal(t, int32(42), model.APIKeyID.ValueInt32())
+	assert.Equal(t, testAPIKeyType, model.APIKeyType.ValueString(), "values not returned by the credential process should not be overwritten")
+	assert.Equal(t, 1, credentialHelperRuns(t, counterFile))
+}
+
+// TestCredentialProcessCaching verifies that the output of the credential
+// process is reused until it expires
+func TestCredentialProcessCaching(t *testing.T) {
+	testCases := []struct {
+		name         string
+		expiration   string
+		expectedRuns int
+	}{
+		{
+			name:         "no expiration",
+			expectedRuns: 1,
+		},
+		{
+			name:         "valid",
+			expiration:   time.Now().Add(time.Hour).Format(time.RFC3339),
+			expectedRuns: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output := `{"api_key": "key-from-credential-process", "api_key_id": 42}`
+			if tc.expiration != "" {
+				output = fmt.Sprintf(`{"api_key": "key-from-credential-process", "api_key_id": 42, "expiration": "%s"}`, tc.expiration)
+			}
+			command, counterFile := createCredentialHelper(t, output, 0)
+
+			for range 2 {
+				var diags diag.Diagnostics
+				model := CortexCloudProviderModel{
+					CredentialProcess: types.StringValue(command),
+				}
+				model.ParseCredentialProcess(context.Background(), &diags)
+				require.False(t, diags.HasError(), "ParseCredentialProcess produced diagnostics: %v", diags.Errors())
+				assert.Equal(t, "key-from-credential-process", model.APIKey.ValueString())
+			}
+
+			assert.Equal(t, tc.expectedRuns, credentialHelperRuns(t, counterFile))
+		})
+	}
+}
+
+// TestCredentialProcessExpiry verifies that the credential process is run
+// again once the cached credentials expire, and that the new output replaces
+// the cached output
+func TestCredentialProcessExpiry(t *testing.T) {
+	command, counterFile := createCredentialHelper(t, fmt.Sprintf(`{"api_key": "first-key", "expiration": "%s"}`, time.Now().Add(time.Hour).Format(time.RFC3339)), 0)
+
+	parse := func() string {
+		t.Helper()
+
+		var diags diag.Diagnostics
+		model := CortexCloudProviderModel{
+			CredentialProcess: types.StringValue(command),
+		}
+		model.ParseCredentialProcess(context.Background(), &diags)
+		require.False(t, diags.HasError(), "ParseCredentialProcess produced diagnostics: %v", diags.Errors())
+
+		return model.APIKey.ValueString()
+	}
+
+	assert.Equal(t, "first-key", parse())
+
+	// Replace the helper's output, which must not be used while the cached
+	// credentials are valid
+	rotated := fmt.Sprintf("#!/bin/sh\necho run >> '%s'\necho '{\"api_key\": \"second-key\"}'\n", counterFile)
+	require.NoError(t, os.WriteFile(command, []byte(rotated), 0o700))
+
+	assert.Equal(t, "first-key", parse())
+	assert.Equal(t, 1, credentialHelperRuns(t, counterFile))
+
+	// Expire the cached credentials
+	credentialProcessCache.Lock()
+	output := credentialProcessCache.outputs[command]
+	expired := time.Now().Add(-time.Minute)
+	output.Expiration = &expired
+	credentialProcessCache.outputs[command] = output
+	credentialProcessCache.Unlock()
+
+	assert.Equal(t, "second-key", parse())
+	assert.Equal(t, 2, credentialHelperRuns(t, counterFile))
+}
+
+// TestCredentialProcessErrors verifies that the provider reports an error if
+// the credential process fails or writes unusable output
+func TestCredentialProcessErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		output   string
+		exitCode int
+		expected string
+	}{
+		{
+			name:     "non-zero exit code",
+			output:   `{"api_key": "key-from-credential-process"}`,
+			exitCode: 1,
+			expected: "helper failed",
+		},
+		{
+			name:     "invalid JSON",
+			output:   "not json",
+			expected: "error parsing output as JSON",
+		},
+		{
+			name:     "missing api_key",
+			output:   `{"api_url": "https://api-credential.process"}`,
+			expected: `does not contain an "api_key" value`,
+		},
+		{
+			name:     "expired",
+			output:   `{"api_key": "key-from-credential-process", "expiration": "2000-01-01T00:00:00Z"}`,
+			expected: "expired at 2000-01-01T00:00:00Z",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			command, _ := createCredentialHelper(t, tc.output, tc.exitCode)
+
+			var diags diag.Diagnostics
+			model := CortexCloudProviderModel{
+				APIKey:            types.StringValue("key-from-provider-block"),
+				CredentialProcess: types.StringValue(command),
+			}
+			model.ParseCredentialProcess(context.Background(), &diags)
+
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tc.expected)
+			assert.Equal(t, "key-from-provider-block", model.APIKey.ValueString())
+		})
+	}
+}
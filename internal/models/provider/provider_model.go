@@ -6,9 +6,13 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/PaloAltoNetworks/cortex-cloud-go/appsec"
 	"github.com/PaloAltoNetworks/cortex-cloud-go/cloudonboarding"
@@ -21,62 +25,341 @@ import (
 
 	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
 )
 
 const (
-	APIURLEnvVar               = "CORTEXCLOUD_API_URL"
-	APIKeyEnvVar               = "CORTEXCLOUD_API_KEY"
-	APIKeyIDEnvVar             = "CORTEXCLOUD_API_KEY_ID"
-	APIKeyTypeEnvVar           = "CORTEXCLOUD_API_KEY_TYPE"
-	SDKLogLevelEnvVar          = "CORTEXCLOUD_SDK_LOG_LEVEL"
-	SkipSSLVerifyEnvVar        = "CORTEXCLOUD_SKIP_SSL_VERIFY"
-	RequestTimeoutEnvVar       = "CORTEXCLOUD_REQUEST_TIMEOUT"
-	RequestMaxRetriesEnvVar    = "CORTEXCLOUD_REQUEST_MAX_RETRIES"
-	RequestMaxRetryDelayEnvVar = "CORTEXCLOUD_REQUEST_MAX_RETRY_DELAY"
-	CrashStackDirEnvVar        = "CORTEXCLOUD_CRASH_STACK_DIR"
+	APIURLEnvVar                = "CORTEXCLOUD_API_URL"
+	APIKeyEnvVar                = "CORTEXCLOUD_API_KEY"
+	APIKeyIDEnvVar              = "CORTEXCLOUD_API_KEY_ID"
+	APIKeyTypeEnvVar            = "CORTEXCLOUD_API_KEY_TYPE"
+	SDKLogLevelEnvVar           = "CORTEXCLOUD_SDK_LOG_LEVEL"
+	SkipSSLVerifyEnvVar         = "CORTEXCLOUD_SKIP_SSL_VERIFY"
+	RequestTimeoutEnvVar        = "CORTEXCLOUD_REQUEST_TIMEOUT"
+	RequestMaxRetriesEnvVar     = "CORTEXCLOUD_REQUEST_MAX_RETRIES"
+	RequestMaxRetryDelayEnvVar  = "CORTEXCLOUD_REQUEST_MAX_RETRY_DELAY"
+	CrashStackDirEnvVar         = "CORTEXCLOUD_CRASH_STACK_DIR"
+	ProfileEnvVar               = "CORTEXCLOUD_PROFILE"
+	CredentialProcessEnvVar     = "CORTEXCLOUD_CREDENTIAL_PROCESS"
+	MaxRequestsPerSecondEnvVar  = "CORTEXCLOUD_MAX_REQUESTS_PER_SECOND"
+	MaxConcurrentRequestsEnvVar = "CORTEXCLOUD_MAX_CONCURRENT_REQUESTS"
+	ProxyURLEnvVar              = "CORTEXCLOUD_PROXY_URL"
+	CACertFileEnvVar            = "CORTEXCLOUD_CA_CERT_FILE"
+	CACertPEMEnvVar             = "CORTEXCLOUD_CA_CERT_PEM"
+	ClientCertEnvVar            = "CORTEXCLOUD_CLIENT_CERT"
+	ClientKeyEnvVar             = "CORTEXCLOUD_CLIENT_KEY"
+	DetectExternalChangesEnvVar = "CORTEXCLOUD_DETECT_EXTERNAL_CHANGES"
+	APIKeyIdentityEnvVar        = "CORTEXCLOUD_API_KEY_IDENTITY"
+	ValidateCredentialsEnvVar   = "CORTEXCLOUD_VALIDATE_CREDENTIALS"
+	HTTPTraceEnvVar             = "CORTEXCLOUD_HTTP_TRACE"
+	HTTPTraceFileEnvVar         = "CORTEXCLOUD_HTTP_TRACE_FILE"
 )
 
+// DefaultConfigFile is the path of the config file, relative to the user's
+// home directory, that is read when a profile is selected without
+// specifying the `config_file` attribute.
+var DefaultConfigFile = filepath.Join(".cortexcloud", "config")
+
 type CortexCloudProviderModel struct {
-	APIURL               types.String `tfsdk:"api_url"`
-	APIKey               types.String `tfsdk:"api_key"`
-	APIKeyID             types.Int32  `tfsdk:"api_key_id"`
-	APIKeyType           types.String `tfsdk:"api_key_type"`
-	ConfigFile           types.String `tfsdk:"config_file"`
-	SkipSSLVerify        types.Bool   `tfsdk:"skip_ssl_verify"`
-	SDKLogLevel          types.String `tfsdk:"sdk_log_level"`
-	RequestTimeout       types.Int32  `tfsdk:"request_timeout"`
-	RequestMaxRetries    types.Int32  `tfsdk:"request_max_retries"`
-	RequestMaxRetryDelay types.Int32  `tfsdk:"request_max_retry_delay"`
-	CrashStackDir        types.String `tfsdk:"crash_stack_dir"`
+	APIURL                types.String    `tfsdk:"api_url"`
+	APIKey                types.String    `tfsdk:"api_key"`
+	APIKeyID              types.Int32     `tfsdk:"api_key_id"`
+	APIKeyType            types.String    `tfsdk:"api_key_type"`
+	ConfigFile            types.String    `tfsdk:"config_file"`
+	Profile               types.String    `tfsdk:"profile"`
+	CredentialProcess     types.String    `tfsdk:"credential_process"`
+	ValidateCredentials   types.Bool      `tfsdk:"validate_credentials"`
+	SkipSSLVerify         types.Bool      `tfsdk:"skip_ssl_verify"`
+	ProxyURL              types.String    `tfsdk:"proxy_url"`
+	CACertFile            types.String    `tfsdk:"ca_cert_file"`
+	CACertPEM             types.String    `tfsdk:"ca_cert_pem"`
+	ClientCert            types.String    `tfsdk:"client_cert"`
+	ClientKey             types.String    `tfsdk:"client_key"`
+	SDKLogLevel           types.String    `tfsdk:"sdk_log_level"`
+	HTTPTrace             types.Bool      `tfsdk:"http_trace"`
+	HTTPTraceFile         types.String    `tfsdk:"http_trace_file"`
+	RequestTimeout        types.Int32     `tfsdk:"request_timeout"`
+	RequestMaxRetries     types.Int32     `tfsdk:"request_max_retries"`
+	RequestMaxRetryDelay  types.Int32     `tfsdk:"request_max_retry_delay"`
+	MaxRequestsPerSecond  types.Int32     `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int32     `tfsdk:"max_concurrent_requests"`
+	CrashStackDir         types.String    `tfsdk:"crash_stack_dir"`
+	DefaultLabels         types.Set       `tfsdk:"default_labels"`
+	DetectExternalChanges types.Bool      `tfsdk:"detect_external_changes"`
+	APIKeyIdentity        types.String    `tfsdk:"api_key_identity"`
+	Endpoints             *EndpointsModel `tfsdk:"endpoints"`
+}
+
+// EndpointsModel contains the base URLs that override `api_url` for the
+// requests sent by the SDK client of each API domain.
+type EndpointsModel struct {
+	AppSec          types.String `tfsdk:"appsec"`
+	CloudOnboarding types.String `tfsdk:"cloudonboarding"`
+	CloudSec        types.String `tfsdk:"cloudsec"`
+	Compliance      types.String `tfsdk:"compliance"`
+	CWP             types.String `tfsdk:"cwp"`
+	Platform        types.String `tfsdk:"platform"`
+	Vulnerability   types.String `tfsdk:"vulnerability"`
+}
+
+// endpointAttribute is an endpoint override and its attribute name.
+type endpointAttribute struct {
+	name  string
+	value types.String
+}
+
+// endpointAttributes returns the endpoint overrides along with their
+// attribute names.
+func (e *EndpointsModel) endpointAttributes() []endpointAttribute {
+	return []endpointAttribute{
+		{"appsec", e.AppSec},
+		{"cloudonboarding", e.CloudOnboarding},
+		{"cloudsec", e.CloudSec},
+		{"compliance", e.Compliance},
+		{"cwp", e.CWP},
+		{"platform", e.Platform},
+		{"vulnerability", e.Vulnerability},
+	}
 }
 
+// CortexCloudSDKClients contains the SDK client of each API domain. Each
+// client is initialized on first use, so that only the domains used by a
+// configuration are set up.
 type CortexCloudSDKClients struct {
-	AppSec          *appsec.Client
-	CloudOnboarding *cloudonboarding.Client
-	CloudSec        *cloudsec.Client
-	Compliance      *compliance.Client
-	CWP             *cwp.Client
-	Platform        *platform.Client
-	Vulnerability   *vulnerability.Client
+	AppSec          *SDKClient[*appsec.Client]
+	CloudOnboarding *SDKClient[*cloudonboarding.Client]
+	CloudSec        *SDKClient[*cloudsec.Client]
+	Compliance      *SDKClient[*compliance.Client]
+	CWP             *SDKClient[*cwp.Client]
+	Platform        *SDKClient[*platform.Client]
+	Vulnerability   *SDKClient[*vulnerability.Client]
+
+	// DefaultLabels contains the provider's `default_labels`, which are
+	// merged into the labels of every resource that supports labels.
+	DefaultLabels []string
+
+	// ExternalChanges configures the warnings emitted when a resource was
+	// modified outside of Terraform.
+	ExternalChanges util.ExternalChangeDetection
+}
+
+// configFileValues contains the provider configuration values that may be
+// specified in the config file, either at the top level of the file or in a
+// named profile.
+type configFileValues struct {
+	APIURL                *string              `json:"api_url" yaml:"api_url"`
+	APIKey                *string              `json:"api_key" yaml:"api_key"`
+	APIKeyID              *int32               `json:"api_key_id" yaml:"api_key_id"`
+	APIKeyType            *string              `json:"api_key_type" yaml:"api_key_type"`
+	CredentialProcess     *string              `json:"credential_process" yaml:"credential_process"`
+	ValidateCredentials   *bool                `json:"validate_credentials" yaml:"validate_credentials"`
+	SkipSSLVerify         *bool                `json:"skip_ssl_verify" yaml:"skip_ssl_verify"`
+	ProxyURL              *string              `json:"proxy_url" yaml:"proxy_url"`
+	CACertFile            *string              `json:"ca_cert_file" yaml:"ca_cert_file"`
+	CACertPEM             *string              `json:"ca_cert_pem" yaml:"ca_cert_pem"`
+	ClientCert            *string              `json:"client_cert" yaml:"client_cert"`
+	ClientKey             *string              `json:"client_key" yaml:"client_key"`
+	SDKLogLevel           *string              `json:"sdk_log_level" yaml:"sdk_log_level"`
+	HTTPTrace             *bool                `json:"http_trace" yaml:"http_trace"`
+	HTTPTraceFile         *string              `json:"http_trace_file" yaml:"http_trace_file"`
+	RequestTimeout        *int32               `json:"request_timeout" yaml:"request_timeout"`
+	RequestMaxRetries     *int32               `json:"request_max_retries" yaml:"request_max_retries"`
+	RequestMaxRetryDelay  *int32               `json:"request_max_retry_delay" yaml:"request_max_retry_delay"`
+	MaxRequestsPerSecond  *int32               `json:"max_requests_per_second" yaml:"max_requests_per_second"`
+	MaxConcurrentRequests *int32               `json:"max_concurrent_requests" yaml:"max_concurrent_requests"`
+	CrashStackDir         *string              `json:"crash_stack_dir" yaml:"crash_stack_dir"`
+	DefaultLabels         []string             `json:"default_labels" yaml:"default_labels"`
+	DetectExternalChanges *bool                `json:"detect_external_changes" yaml:"detect_external_changes"`
+	APIKeyIdentity        *string              `json:"api_key_identity" yaml:"api_key_identity"`
+	Endpoints             *configFileEndpoints `json:"endpoints" yaml:"endpoints"`
+}
+
+// configFileEndpoints contains the endpoint overrides that may be specified
+// in the config file.
+type configFileEndpoints struct {
+	AppSec          *string `json:"appsec" yaml:"appsec"`
+	CloudOnboarding *string `json:"cloudonboarding" yaml:"cloudonboarding"`
+	CloudSec        *string `json:"cloudsec" yaml:"cloudsec"`
+	Compliance      *string `json:"compliance" yaml:"compliance"`
+	CWP             *string `json:"cwp" yaml:"cwp"`
+	Platform        *string `json:"platform" yaml:"platform"`
+	Vulnerability   *string `json:"vulnerability" yaml:"vulnerability"`
+}
+
+// merge overwrites the values of v with the non-nil values of other.
+func (v *configFileValues) merge(other configFileValues) {
+	if other.APIURL != nil {
+		v.APIURL = other.APIURL
+	}
+	if other.APIKey != nil {
+		v.APIKey = other.APIKey
+	}
+	if other.APIKeyID != nil {
+		v.APIKeyID = other.APIKeyID
+	}
+	if other.APIKeyType != nil {
+		v.APIKeyType = other.APIKeyType
+	}
+	if other.CredentialProcess != nil {
+		v.CredentialProcess = other.CredentialProcess
+	}
+	if other.ValidateCredentials != nil {
+		v.ValidateCredentials = other.ValidateCredentials
+	}
+	if other.SkipSSLVerify != nil {
+		v.SkipSSLVerify = other.SkipSSLVerify
+	}
+	if other.ProxyURL != nil {
+		v.ProxyURL = other.ProxyURL
+	}
+	if other.CACertFile != nil {
+		v.CACertFile = other.CACertFile
+	}
+	if other.CACertPEM != nil {
+		v.CACertPEM = other.CACertPEM
+	}
+	if other.ClientCert != nil {
+		v.ClientCert = other.ClientCert
+	}
+	if other.ClientKey != nil {
+		v.ClientKey = other.ClientKey
+	}
+	if other.SDKLogLevel != nil {
+		v.SDKLogLevel = other.SDKLogLevel
+	}
+	if other.HTTPTrace != nil {
+		v.HTTPTrace = other.HTTPTrace
+	}
+	if other.HTTPTraceFile != nil {
+		v.HTTPTraceFile = other.HTTPTraceFile
+	}
+	if other.RequestTimeout != nil {
+		v.RequestTimeout = other.RequestTimeout
+	}
+	if other.RequestMaxRetries != nil {
+		v.RequestMaxRetries = other.RequestMaxRetries
+	}
+	if other.RequestMaxRetryDelay != nil {
+		v.RequestMaxRetryDelay = other.RequestMaxRetryDelay
+	}
+	if other.MaxRequestsPerSecond != nil {
+		v.MaxRequestsPerSecond = other.MaxRequestsPerSecond
+	}
+	if other.MaxConcurrentRequests != nil {
+		v.MaxConcurrentRequests = other.MaxConcurrentRequests
+	}
+	if other.CrashStackDir != nil {
+		v.CrashStackDir = other.CrashStackDir
+	}
+	if other.DefaultLabels != nil {
+		v.DefaultLabels = other.DefaultLabels
+	}
+	if other.DetectExternalChanges != nil {
+		v.DetectExternalChanges = other.DetectExternalChanges
+	}
+	if other.APIKeyIdentity != nil {
+		v.APIKeyIdentity = other.APIKeyIdentity
+	}
+	if other.Endpoints != nil {
+		if v.Endpoints == nil {
+			v.Endpoints = &configFileEndpoints{}
+		}
+		v.Endpoints.merge(*other.Endpoints)
+	}
+}
+
+// merge overwrites the values of e with the non-nil values of other.
+func (e *configFileEndpoints) merge(other configFileEndpoints) {
+	for _, field := range []struct{ dest, src **string }{
+		{&e.AppSec, &other.AppSec},
+		{&e.CloudOnboarding, &other.CloudOnboarding},
+		{&e.CloudSec, &other.CloudSec},
+		{&e.Compliance, &other.Compliance},
+		{&e.CWP, &other.CWP},
+		{&e.Platform, &other.Platform},
+		{&e.Vulnerability, &other.Vulnerability},
+	} {
+		if *field.src != nil {
+			*field.dest = *field.src
+		}
+	}
+}
+
+// configFile is the structure of the config file. The values at the top
+// level of the file apply to every profile, and are overwritten by the
+// values of the selected profile.
+type configFile struct {
+	configFileValues `yaml:",inline"`
+
+	Profiles map[string]configFileValues `json:"profiles" yaml:"profiles"`
+}
+
+// unmarshalConfigFile decodes the contents of the config file at the given
+// path. Files with a ".json" extension are decoded as JSON and files with a
+// ".yaml" or ".yml" extension as YAML. Files with any other extension, such
+// as the default config file, may use either format.
+func unmarshalConfigFile(filePath string, data []byte, config *configFile) error {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".json":
+		return json.Unmarshal(data, config)
+	case ".yaml", ".yml":
+		return yaml.Unmarshal(data, config)
+	}
+
+	if json.Valid(data) {
+		return json.Unmarshal(data, config)
+	}
+
+	return yaml.Unmarshal(data, config)
 }
 
-// ParseConfigFile reads the JSON file at the filepath specified in the
-// provider block's `config_file` argument and overwrites the provider
+// ParseConfigFile reads the JSON or YAML file at the filepath specified in
+// the provider block's `config_file` argument and overwrites the provider
 // configuration values with their respective non-nil config file values.
+//
+// If a profile is selected using the `profile` argument or the
+// CORTEXCLOUD_PROFILE environment variable, the values of that profile are
+// applied on top of the values at the top level of the file. When no
+// `config_file` is specified, the profile is read from DefaultConfigFile in
+// the user's home directory.
 func (m *CortexCloudProviderModel) ParseConfigFile(ctx context.Context, diagnostics *diag.Diagnostics) {
-	if m.ConfigFile.IsNull() {
-		tflog.Debug(ctx, "No config file specified -- Skipping parsing.")
-		return
+	// The profile determines which values are read from the config file, so
+	// its environment variable is applied before the file is parsed rather
+	// than in ParseEnvVars.
+	util.ApplyStringEnvVar(ctx, ProfileEnvVar, &m.Profile)
+	profile := m.Profile.ValueString()
+
+	configFilePath := m.ConfigFile.ValueString()
+	attributePath := path.Root("config_file")
+	if m.ConfigFile.IsNull() || configFilePath == "" {
+		if profile == "" {
+			tflog.Debug(ctx, "No config file specified -- Skipping parsing.")
+			return
+		}
+
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Provider Configuration Error",
+				fmt.Sprintf("Error occured locating the default config file: %s. Specify the path to the config file using the `config_file` attribute.", err.Error()),
+			)
+			return
+		}
+
+		configFilePath = filepath.Join(homeDir, DefaultConfigFile)
+		attributePath = path.Root("profile")
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Parsing config file at %s", m.ConfigFile.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("Parsing config file at %s", configFilePath))
 
 	// Handle different OS path formats
-	cleanedPath := filepath.Clean(m.ConfigFile.ValueString())
+	cleanedPath := filepath.Clean(configFilePath)
 
 	data, err := os.ReadFile(cleanedPath)
 	if err != nil {
@@ -90,7 +373,7 @@ func (m *CortexCloudProviderModel) ParseConfigFile(ctx context.Context, diagnost
 		}
 
 		diagnostics.AddAttributeError(
-			path.Root("config_file"),
+			attributePath,
 			"Provider Configuration Error",
 			fmt.Sprintf("Error occured reading config file: %s", errMsg),
 		)
@@ -98,30 +381,46 @@ func (m *CortexCloudProviderModel) ParseConfigFile(ctx context.Context, diagnost
 		return
 	}
 
-	config := struct {
-		APIURL               *string `json:"api_url"`
-		APIKey               *string `json:"api_key"`
-		APIKeyID             *int32  `json:"api_key_id"`
-		APIKeyType           *string `json:"api_key_type"`
-		SkipSSLVerify        *bool   `json:"skip_ssl_verify"`
-		SDKLogLevel          *string `json:"sdk_log_level"`
-		RequestTimeout       *int32  `json:"request_timeout"`
-		RequestMaxRetries    *int32  `json:"request_max_retries"`
-		RequestMaxRetryDelay *int32  `json:"request_max_retry_delay"`
-		CrashStackDir        *string `json:"crash_stack_dir"`
-	}{}
-
-	if err := json.Unmarshal(data, &config); err != nil {
+	var config configFile
+	if err := unmarshalConfigFile(cleanedPath, data, &config); err != nil {
 		diagnostics.AddAttributeError(
-			path.Root("config_file"),
+			attributePath,
 			"Provider Configuration Error",
 			fmt.Sprintf("Error occured unmarshalling config file: %s", err.Error()),
 		)
 		return
 	}
 
+	values := config.configFileValues
+	if profile != "" {
+		profileValues, ok := config.Profiles[profile]
+		if !ok {
+			profiles := make([]string, 0, len(config.Profiles))
+			for name := range config.Profiles {
+				profiles = append(profiles, name)
+			}
+			slices.Sort(profiles)
+
+			diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Provider Configuration Error",
+				fmt.Sprintf("Profile \"%s\" not found in config file %s. Available profiles: %s", profile, cleanedPath, strings.Join(profiles, ", ")),
+			)
+			return
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf(`Applying profile "%s" from config file`, profile))
+		values.merge(profileValues)
+	}
+
 	tflog.Debug(ctx, "Config file successfully parsed")
 
+	m.applyConfigFileValues(ctx, values)
+}
+
+// applyConfigFileValues overwrites the provider configuration values with
+// the given non-nil config file values.
+func (m *CortexCloudProviderModel) applyConfigFileValues(ctx context.Context, config configFileValues) {
 	if config.APIURL != nil && *config.APIURL != "" {
 		tflog.Debug(ctx, fmt.Sprintf(`Overwriting api_url from config file: "%s" => "%s"`, m.APIURL.ValueString(), *config.APIURL))
 		m.APIURL = types.StringValue(*config.APIURL)
@@ -138,14 +437,50 @@ func (m *CortexCloudProviderModel) ParseConfigFile(ctx context.Context, diagnost
 		tflog.Debug(ctx, fmt.Sprintf(`Overwriting api_key_type from config file: "%s" => "%s"`, m.APIKeyType.ValueString(), *config.APIKeyType))
 		m.APIKeyType = types.StringValue(*config.APIKeyType)
 	}
+	if config.CredentialProcess != nil && *config.CredentialProcess != "" {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting credential_process from config file: "%s" => "%s"`, m.CredentialProcess.ValueString(), *config.CredentialProcess))
+		m.CredentialProcess = types.StringValue(*config.CredentialProcess)
+	}
+	if config.ValidateCredentials != nil {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting validate_credentials from config file: "%t" => "%t"`, m.ValidateCredentials.ValueBool(), *config.ValidateCredentials))
+		m.ValidateCredentials = types.BoolValue(*config.ValidateCredentials)
+	}
 	if config.SkipSSLVerify != nil {
 		tflog.Debug(ctx, fmt.Sprintf(`Overwriting skip_ssl_verify from config file: "%t" => "%t"`, m.SkipSSLVerify.ValueBool(), *config.SkipSSLVerify))
 		m.SkipSSLVerify = types.BoolValue(*config.SkipSSLVerify)
 	}
+	if config.ProxyURL != nil && *config.ProxyURL != "" {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting proxy_url from config file: "%s" => "%s"`, m.ProxyURL.ValueString(), *config.ProxyURL))
+		m.ProxyURL = types.StringValue(*config.ProxyURL)
+	}
+	if config.CACertFile != nil && *config.CACertFile != "" {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting ca_cert_file from config file: "%s" => "%s"`, m.CACertFile.ValueString(), *config.CACertFile))
+		m.CACertFile = types.StringValue(*config.CACertFile)
+	}
+	if config.CACertPEM != nil && *config.CACertPEM != "" {
+		tflog.Debug(ctx, "Overwriting ca_cert_pem from config file")
+		m.CACertPEM = types.StringValue(*config.CACertPEM)
+	}
+	if config.ClientCert != nil && *config.ClientCert != "" {
+		tflog.Debug(ctx, "Overwriting client_cert from config file")
+		m.ClientCert = types.StringValue(*config.ClientCert)
+	}
+	if config.ClientKey != nil && *config.ClientKey != "" {
+		tflog.Debug(ctx, "Overwriting client_key from config file")
+		m.ClientKey = types.StringValue(*config.ClientKey)
+	}
 	if config.SDKLogLevel != nil && *config.SDKLogLevel != "" {
 		tflog.Debug(ctx, fmt.Sprintf(`Overwriting sdk_log_level from config file: "%s" => "%s"`, m.SDKLogLevel.ValueString(), *config.SDKLogLevel))
 		m.SDKLogLevel = types.StringValue(*config.SDKLogLevel)
 	}
+	if config.HTTPTrace != nil {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting http_trace from config file: "%t" => "%t"`, m.HTTPTrace.ValueBool(), *config.HTTPTrace))
+		m.HTTPTrace = types.BoolValue(*config.HTTPTrace)
+	}
+	if config.HTTPTraceFile != nil && *config.HTTPTraceFile != "" {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting http_trace_file from config file: "%s" => "%s"`, m.HTTPTraceFile.ValueString(), *config.HTTPTraceFile))
+		m.HTTPTraceFile = types.StringValue(*config.HTTPTraceFile)
+	}
 	if config.RequestTimeout != nil {
 		tflog.Debug(ctx, fmt.Sprintf(`Overwriting request_timeout from config file: "%d" => "%d"`, m.RequestTimeout.ValueInt32(), *config.RequestTimeout))
 		m.RequestTimeout = types.Int32Value(*config.RequestTimeout)
@@ -158,10 +493,58 @@ func (m *CortexCloudProviderModel) ParseConfigFile(ctx context.Context, diagnost
 		tflog.Debug(ctx, fmt.Sprintf(`Overwriting request_max_retry_delay from config file: "%d" => "%d"`, m.RequestMaxRetryDelay.ValueInt32(), *config.RequestMaxRetryDelay))
 		m.RequestMaxRetryDelay = types.Int32Value(*config.RequestMaxRetryDelay)
 	}
+	if config.MaxRequestsPerSecond != nil {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting max_requests_per_second from config file: "%d" => "%d"`, m.MaxRequestsPerSecond.ValueInt32(), *config.MaxRequestsPerSecond))
+		m.MaxRequestsPerSecond = types.Int32Value(*config.MaxRequestsPerSecond)
+	}
+	if config.MaxConcurrentRequests != nil {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting max_concurrent_requests from config file: "%d" => "%d"`, m.MaxConcurrentRequests.ValueInt32(), *config.MaxConcurrentRequests))
+		m.MaxConcurrentRequests = types.Int32Value(*config.MaxConcurrentRequests)
+	}
 	if config.CrashStackDir != nil && *config.CrashStackDir != "" {
 		tflog.Debug(ctx, fmt.Sprintf(`Overwriting crash_stack_dir from config file: "%s" => "%s"`, m.CrashStackDir.ValueString(), *config.CrashStackDir))
 		m.CrashStackDir = types.StringValue(*config.CrashStackDir)
 	}
+	if config.DefaultLabels != nil {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting default_labels from config file: "%s" => "%s"`, m.DefaultLabels.String(), strings.Join(config.DefaultLabels, ",")))
+		labels := slices.Compact(slices.Sorted(slices.Values(config.DefaultLabels)))
+		elements := make([]attr.Value, len(labels))
+		for i, label := range labels {
+			elements[i] = types.StringValue(label)
+		}
+		m.DefaultLabels = types.SetValueMust(types.StringType, elements)
+	}
+	if config.DetectExternalChanges != nil {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting detect_external_changes from config file: "%t" => "%t"`, m.DetectExternalChanges.ValueBool(), *config.DetectExternalChanges))
+		m.DetectExternalChanges = types.BoolValue(*config.DetectExternalChanges)
+	}
+	if config.APIKeyIdentity != nil && *config.APIKeyIdentity != "" {
+		tflog.Debug(ctx, fmt.Sprintf(`Overwriting api_key_identity from config file: "%s" => "%s"`, m.APIKeyIdentity.ValueString(), *config.APIKeyIdentity))
+		m.APIKeyIdentity = types.StringValue(*config.APIKeyIdentity)
+	}
+	if config.Endpoints != nil {
+		if m.Endpoints == nil {
+			m.Endpoints = &EndpointsModel{}
+		}
+		for _, endpoint := range []struct {
+			name  string
+			dest  *types.String
+			value *string
+		}{
+			{"appsec", &m.Endpoints.AppSec, config.Endpoints.AppSec},
+			{"cloudonboarding", &m.Endpoints.CloudOnboarding, config.Endpoints.CloudOnboarding},
+			{"cloudsec", &m.Endpoints.CloudSec, config.Endpoints.CloudSec},
+			{"compliance", &m.Endpoints.Compliance, config.Endpoints.Compliance},
+			{"cwp", &m.Endpoints.CWP, config.Endpoints.CWP},
+			{"platform", &m.Endpoints.Platform, config.Endpoints.Platform},
+			{"vulnerability", &m.Endpoints.Vulnerability, config.Endpoints.Vulnerability},
+		} {
+			if endpoint.value != nil && *endpoint.value != "" {
+				tflog.Debug(ctx, fmt.Sprintf(`Overwriting endpoints.%s from config file: "%s" => "%s"`, endpoint.name, endpoint.dest.ValueString(), *endpoint.value))
+				*endpoint.dest = types.StringValue(*endpoint.value)
+			}
+		}
+	}
 }
 
 func (m *CortexCloudProviderModel) ParseEnvVars(ctx context.Context, diagnostics *diag.Diagnostics) {
@@ -170,12 +553,25 @@ func (m *CortexCloudProviderModel) ParseEnvVars(ctx context.Context, diagnostics
 	util.ApplyStringEnvVar(ctx, APIKeyEnvVar, &m.APIKey)
 	util.ApplyInt32EnvVar(ctx, APIKeyIDEnvVar, &m.APIKeyID, diagnostics)
 	util.ApplyStringEnvVar(ctx, APIKeyTypeEnvVar, &m.APIKeyType)
+	util.ApplyStringEnvVar(ctx, CredentialProcessEnvVar, &m.CredentialProcess)
+	util.ApplyBoolEnvVar(ctx, ValidateCredentialsEnvVar, &m.ValidateCredentials, diagnostics)
 	util.ApplyBoolEnvVar(ctx, SkipSSLVerifyEnvVar, &m.SkipSSLVerify, diagnostics)
+	util.ApplyStringEnvVar(ctx, ProxyURLEnvVar, &m.ProxyURL)
+	util.ApplyStringEnvVar(ctx, CACertFileEnvVar, &m.CACertFile)
+	util.ApplyStringEnvVar(ctx, CACertPEMEnvVar, &m.CACertPEM)
+	util.ApplyStringEnvVar(ctx, ClientCertEnvVar, &m.ClientCert)
+	util.ApplyStringEnvVar(ctx, ClientKeyEnvVar, &m.ClientKey)
 	util.ApplyStringEnvVar(ctx, SDKLogLevelEnvVar, &m.SDKLogLevel)
+	util.ApplyBoolEnvVar(ctx, HTTPTraceEnvVar, &m.HTTPTrace, diagnostics)
+	util.ApplyStringEnvVar(ctx, HTTPTraceFileEnvVar, &m.HTTPTraceFile)
 	util.ApplyInt32EnvVar(ctx, RequestTimeoutEnvVar, &m.RequestTimeout, diagnostics)
 	util.ApplyInt32EnvVar(ctx, RequestMaxRetriesEnvVar, &m.RequestMaxRetries, diagnostics)
 	util.ApplyInt32EnvVar(ctx, RequestMaxRetryDelayEnvVar, &m.RequestMaxRetryDelay, diagnostics)
+	util.ApplyInt32EnvVar(ctx, MaxRequestsPerSecondEnvVar, &m.MaxRequestsPerSecond, diagnostics)
+	util.ApplyInt32EnvVar(ctx, MaxConcurrentRequestsEnvVar, &m.MaxConcurrentRequests, diagnostics)
 	util.ApplyStringEnvVar(ctx, CrashStackDirEnvVar, &m.CrashStackDir)
+	util.ApplyBoolEnvVar(ctx, DetectExternalChangesEnvVar, &m.DetectExternalChanges, diagnostics)
+	util.ApplyStringEnvVar(ctx, APIKeyIdentityEnvVar, &m.APIKeyIdentity)
 }
 
 func (m *CortexCloudProviderModel) Validate(ctx context.Context, diags *diag.Diagnostics) {
@@ -193,4 +589,62 @@ func (m *CortexCloudProviderModel) Validate(ctx context.Context, diags *diag.Dia
 	if !m.APIKeyType.IsNull() && !m.APIKeyType.IsUnknown() && !enums.ContainsAPIKeyType(m.APIKeyType.ValueString()) {
 		util.AddInvalidProviderConfigurationValue(diags, "api_key_type", "Cortex Cloud API Key ID", m.APIKeyType.ValueString(), enums.AllAPIKeyTypes())
 	}
+	if m.DetectExternalChanges.ValueBool() && (m.APIKeyIdentity.IsNull() || m.APIKeyIdentity.IsUnknown() || m.APIKeyIdentity.ValueString() == "") {
+		diags.AddAttributeError(
+			path.Root("api_key_identity"),
+			"Cortex Cloud API Key Identity Is Required",
+			fmt.Sprintf("Recieved unknown or empty value for configuration parameter \"api_key_identity\", which is required when \"detect_external_changes\" is true. Either set the value in the provider configuration, or use the %s environment variable.", APIKeyIdentityEnvVar),
+		)
+	}
+	for _, limit := range []struct {
+		name  string
+		value types.Int32
+	}{
+		{"max_requests_per_second", m.MaxRequestsPerSecond},
+		{"max_concurrent_requests", m.MaxConcurrentRequests},
+	} {
+		if !limit.value.IsNull() && !limit.value.IsUnknown() && limit.value.ValueInt32() < 0 {
+			diags.AddAttributeError(
+				path.Root(limit.name),
+				"Invalid Request Limit",
+				fmt.Sprintf("Recieved invalid input for configuration parameter \"%s\": %d. Expected a value greater than or equal to 0.", limit.name, limit.value.ValueInt32()),
+			)
+		}
+	}
+	if _, err := m.Proxy(); err != nil {
+		diags.AddAttributeError(
+			path.Root("proxy_url"),
+			"Invalid Proxy URL",
+			fmt.Sprintf("Recieved invalid input for configuration parameter \"proxy_url\": \"%s\". Expected an absolute URL using the http, https or socks5 scheme: %s", m.ProxyURL.ValueString(), err.Error()),
+		)
+	}
+	// The CA certificates and the client certificate are validated
+	// separately so that errors in both are reported
+	for _, load := range []func() error{
+		func() error { _, err := m.rootCAs(); return err },
+		func() error { _, err := m.clientCertificate(); return err },
+	} {
+		var attrErr *TLSAttributeError
+		if err := load(); errors.As(err, &attrErr) {
+			diags.AddAttributeError(
+				path.Root(attrErr.Attribute),
+				"Invalid TLS Configuration",
+				fmt.Sprintf("Error occured loading the value of configuration parameter \"%s\": %s", attrErr.Attribute, attrErr.Err.Error()),
+			)
+		}
+	}
+	if m.Endpoints != nil {
+		for _, endpoint := range m.Endpoints.endpointAttributes() {
+			if endpoint.value.IsNull() || endpoint.value.IsUnknown() || endpoint.value.ValueString() == "" {
+				continue
+			}
+			if parsed, err := url.Parse(endpoint.value.ValueString()); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+				diags.AddAttributeError(
+					path.Root("endpoints").AtName(endpoint.name),
+					"Invalid Endpoint",
+					fmt.Sprintf("Recieved invalid input for configuration parameter \"endpoints.%s\": \"%s\". Expected an absolute URL using the http or https scheme.", endpoint.name, endpoint.value.ValueString()),
+				)
+			}
+		}
+	}
 }
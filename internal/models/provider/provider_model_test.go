@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -331,6 +332,281 @@ func TestValidate_APIKeyType(t *testing.T) {
 	})
 }
 
+func TestValidate_RequestLimits(t *testing.T) {
+	baseModel := func(requestsPerSecond, maxConcurrent types.Int32) CortexCloudProviderModel {
+		return CortexCloudProviderModel{
+			APIURL:                types.StringValue("https://api.example.com"),
+			APIKey:                types.StringValue("test-key"),
+			APIKeyID:              types.Int32Value(1),
+			MaxRequestsPerSecond:  requestsPerSecond,
+			MaxConcurrentRequests: maxConcurrent,
+		}
+	}
+
+	t.Run("unset and zero limits are accepted", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := baseModel(types.Int32Null(), types.Int32Value(0))
+		m.Validate(context.Background(), &diags)
+		assert.False(t, diags.HasError(), "expected no error for unset limits, got: %v", diags.Errors())
+	})
+
+	t.Run("positive limits are accepted", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := baseModel(types.Int32Value(10), types.Int32Value(4))
+		m.Validate(context.Background(), &diags)
+		assert.False(t, diags.HasError(), "expected no error for positive limits, got: %v", diags.Errors())
+	})
+
+	t.Run("negative limits produce diagnostics", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := baseModel(types.Int32Value(-1), types.Int32Value(-5))
+		m.Validate(context.Background(), &diags)
+		assert.Equal(t, 2, diags.ErrorsCount(), "expected a diagnostic error for each negative limit, got: %v", diags.Errors())
+	})
+}
+
+func TestValidate_Endpoints(t *testing.T) {
+	baseModel := func(endpoints *EndpointsModel) CortexCloudProviderModel {
+		return CortexCloudProviderModel{
+			APIURL:    types.StringValue("https://api.example.com"),
+			APIKey:    types.StringValue("test-key"),
+			APIKeyID:  types.Int32Value(1),
+			Endpoints: endpoints,
+		}
+	}
+
+	t.Run("valid endpoints are accepted", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := baseModel(&EndpointsModel{
+			Platform: types.StringValue("http://127.0.0.1:8080"),
+			CloudSec: types.StringValue("https://cloudsec.staging.example.com"),
+		})
+		m.Validate(context.Background(), &diags)
+		assert.False(t, diags.HasError(), "expected no error for valid endpoints, got: %v", diags.Errors())
+	})
+
+	t.Run("invalid endpoints produce diagnostics", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := baseModel(&EndpointsModel{
+			AppSec:   types.StringValue("ftp://appsec.example.com"),
+			CloudSec: types.StringValue("cloudsec.example.com"),
+		})
+		m.Validate(context.Background(), &diags)
+		assert.Equal(t, 2, diags.ErrorsCount(), "expected a diagnostic error for each invalid endpoint, got: %v", diags.Errors())
+	})
+}
+
+func TestValidate_ExternalChanges(t *testing.T) {
+	baseModel := func(detectExternalChanges types.Bool, apiKeyIdentity types.String) CortexCloudProviderModel {
+		return CortexCloudProviderModel{
+			APIURL:                types.StringValue("https://api.example.com"),
+			APIKey:                types.StringValue("test-key"),
+			APIKeyID:              types.Int32Value(1),
+			DetectExternalChanges: detectExternalChanges,
+			APIKeyIdentity:        apiKeyIdentity,
+		}
+	}
+
+	t.Run("identity is not required when disabled", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := baseModel(types.BoolNull(), types.StringNull())
+		m.Validate(context.Background(), &diags)
+		assert.False(t, diags.HasError(), "expected no error when detect_external_changes is unset, got: %v", diags.Errors())
+	})
+
+	t.Run("identity is accepted when enabled", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := baseModel(types.BoolValue(true), types.StringValue("terraform-automation"))
+		m.Validate(context.Background(), &diags)
+		assert.False(t, diags.HasError(), "expected no error when api_key_identity is set, got: %v", diags.Errors())
+	})
+
+	t.Run("missing identity produces diagnostic when enabled", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := baseModel(types.BoolValue(true), types.StringValue(""))
+		m.Validate(context.Background(), &diags)
+		require.Equal(t, 1, diags.ErrorsCount(), "expected a diagnostic error for the missing api_key_identity, got: %v", diags.Errors())
+		assert.Contains(t, diags.Errors()[0].Detail(), APIKeyIdentityEnvVar)
+	})
+}
+
+// TestConfigFileProfiles verifies that the values of the selected profile
+// take precedence over the values at the top level of the config file, and
+// that only the top level values are applied when no profile is selected
+func TestConfigFileProfiles(t *testing.T) {
+	const jsonConfig = `{
+		"api_url": "https://api-top.level",
+		"api_key_type": "standard",
+		"request_timeout": 111,
+		"profiles": {
+			"dev": {"api_url": "https://api-dev.tenant", "api_key": "dev-key", "api_key_id": 1},
+			"prod": {"api_url": "https://api-prod.tenant", "api_key": "prod-key", "api_key_id": 2, "request_timeout": 222}
+		}
+	}`
+	const yamlConfig = `
+api_url: https://api-top.level
+api_key_type: standard
+request_timeout: 111
+profiles:
+  dev:
+    api_url: https://api-dev.tenant
+    api_key: dev-key
+    api_key_id: 1
+  prod:
+    api_url: https://api-prod.tenant
+    api_key: prod-key
+    api_key_id: 2
+    request_timeout: 222
+`
+
+	testCases := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{name: "json", file: "config.json", content: jsonConfig},
+		{name: "yaml", file: "config.yaml", content: yamlConfig},
+		{name: "yaml without extension", file: "config", content: yamlConfig},
+		{name: "json without extension", file: "config", content: jsonConfig},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configFile := writeTempFile(t, t.TempDir(), tc.file, tc.content)
+
+			var diags diag.Diagnostics
+			model := CortexCloudProviderModel{
+				ConfigFile: types.StringValue(configFile),
+				Profile:    types.StringValue("prod"),
+			}
+			model.ParseConfigFile(context.Background(), &diags)
+			require.False(t, diags.HasError(), "ParseConfigFile produced diagnostics: %v", diags.Errors())
+
+			assert.Equal(t, "https://api-prod.tenant", model.APIURL.ValueString())
+			assert.Equal(t, "prod-key", model.APIKey.ValueString())
+			assert.Equal(t, int32(2), model.APIKeyID.ValueInt32())
+			assert.Equal(t, "standard", model.APIKeyType.ValueString())
+			assert.Equal(t, int32(222), model.RequestTimeout.ValueInt32())
+
+			model = CortexCloudProviderModel{
+				ConfigFile: types.StringValue(configFile),
+			}
+			model.ParseConfigFile(context.Background(), &diags)
+			require.False(t, diags.HasError(), "ParseConfigFile produced diagnostics: %v", diags.Errors())
+
+			assert.Equal(t, "https://api-top.level", model.APIURL.ValueString())
+			assert.True(t, model.APIKey.IsNull())
+			assert.Equal(t, int32(111), model.RequestTimeout.ValueInt32())
+		})
+	}
+}
+
+// TestConfigFileProfileEnvVar verifies that the profile can be selected using
+// the CORTEXCLOUD_PROFILE environment variable, and that the environment
+// variable takes precedence over the provider block value like every other
+// environment variable
+func TestConfigFileProfileEnvVar(t *testing.T) {
+	configFile := writeTempFile(t, t.TempDir(), "config.yaml", `
+profiles:
+  dev:
+    api_url: https://api-dev.tenant
+  prod:
+    api_url: https://api-prod.tenant
+`)
+	t.Setenv(ProfileEnvVar, "dev")
+
+	var diags diag.Diagnostics
+	model := CortexCloudProviderModel{
+		ConfigFile: types.StringValue(configFile),
+	}
+	model.ParseConfigFile(context.Background(), &diags)
+	require.False(t, diags.HasError(), "ParseConfigFile produced diagnostics: %v", diags.Errors())
+	assert.Equal(t, "dev", model.Profile.ValueString())
+	assert.Equal(t, "https://api-dev.tenant", model.APIURL.ValueString())
+
+	model = CortexCloudProviderModel{
+		ConfigFile: types.StringValue(configFile),
+		Profile:    types.StringValue("prod"),
+	}
+	model.ParseConfigFile(context.Background(), &diags)
+	require.False(t, diags.HasError(), "ParseConfigFile produced diagnostics: %v", diags.Errors())
+	assert.Equal(t, "dev", model.Profile.ValueString())
+	assert.Equal(t, "https://api-dev.tenant", model.APIURL.ValueString())
+}
+
+// TestConfigFileUnknownProfile verifies that selecting a profile that is not
+// defined in the config file produces an error
+func TestConfigFileUnknownProfile(t *testing.T) {
+	configFile := writeTempFile(t, t.TempDir(), "config.json", `{"profiles": {"prod": {}, "dev": {}}}`)
+
+	var diags diag.Diagnostics
+	model := CortexCloudProviderModel{
+		ConfigFile: types.StringValue(configFile),
+		Profile:    types.StringValue("staging"),
+	}
+	model.ParseConfigFile(context.Background(), &diags)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), `Profile "staging" not found`)
+	assert.Contains(t, diags.Errors()[0].Detail(), "Available profiles: dev, prod")
+}
+
+// TestConfigFileDefaultPath verifies that the profile is read from the
+// default config file in the user's home directory when no `config_file` is
+// specified
+func TestConfigFileDefaultPath(t *testing.T) {
+	homeDir := t.TempDir()
+	t.Setenv("HOME", homeDir)
+	t.Setenv("USERPROFILE", homeDir)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(homeDir, ".cortexcloud"), 0o700))
+	writeTempFile(t, filepath.Join(homeDir, ".cortexcloud"), "config", `
+profiles:
+  dev:
+    api_url: https://api-dev.tenant
+`)
+
+	var diags diag.Diagnostics
+	model := CortexCloudProviderModel{}
+	model.ParseConfigFile(context.Background(), &diags)
+	require.False(t, diags.HasError(), "ParseConfigFile produced diagnostics: %v", diags.Errors())
+	assert.True(t, model.APIURL.IsNull(), "default config file should only be read when a profile is selected")
+
+	model = CortexCloudProviderModel{
+		Profile: types.StringValue("dev"),
+	}
+	model.ParseConfigFile(context.Background(), &diags)
+	require.False(t, diags.HasError(), "ParseConfigFile produced diagnostics: %v", diags.Errors())
+	assert.Equal(t, "https://api-dev.tenant", model.APIURL.ValueString())
+}
+
+// TestConfigFileEndpoints verifies that the endpoint overrides of the
+// selected profile are merged with the endpoint overrides at the top level of
+// the config file
+func TestConfigFileEndpoints(t *testing.T) {
+	configFile := writeTempFile(t, t.TempDir(), "config.yaml", `
+endpoints:
+  platform: https://platform.top.level
+  cwp: https://cwp.top.level
+profiles:
+  staging:
+    endpoints:
+      cwp: https://cwp.staging
+`)
+
+	var diags diag.Diagnostics
+	model := CortexCloudProviderModel{
+		ConfigFile: types.StringValue(configFile),
+		Profile:    types.StringValue("staging"),
+	}
+	model.ParseConfigFile(context.Background(), &diags)
+	require.False(t, diags.HasError(), "ParseConfigFile produced diagnostics: %v", diags.Errors())
+
+	require.NotNil(t, model.Endpoints)
+	assert.Equal(t, "https://platform.top.level", model.Endpoints.Platform.ValueString())
+	assert.Equal(t, "https://cwp.staging", model.Endpoints.CWP.ValueString())
+	assert.True(t, model.Endpoints.AppSec.IsNull())
+}
+
 // createTempConfigFile is a helper function to create a temporary JSON
 // configuration file
 func createTempConfigFile(t *testing.T, filepath string, content map[string]any) string {
@@ -355,3 +631,74 @@ func createTempConfigFile(t *testing.T, filepath string, content map[string]any)
 
 	return file.Name()
 }
+
+// TestConfigFileDefaultLabels verifies that the default labels are read from
+// the config file and that the labels of the selected profile replace those
+// at the top level of the file
+func TestConfigFileDefaultLabels(t *testing.T) {
+	dir := t.TempDir()
+	configFile := writeTempFile(t, dir, "config.yaml", `
+default_labels:
+  - managed-by:terraform
+profiles:
+  team-x:
+    default_labels:
+      - managed-by:terraform
+      - team:x
+      - team:x
+`)
+
+	var diags diag.Diagnostics
+	m := CortexCloudProviderModel{ConfigFile: types.StringValue(configFile)}
+	m.ParseConfigFile(context.Background(), &diags)
+	require.False(t, diags.HasError(), "unexpected errors: %v", diags.Errors())
+
+	var labels []string
+	require.False(t, m.DefaultLabels.ElementsAs(context.Background(), &labels, false).HasError())
+	assert.ElementsMatch(t, []string{"managed-by:terraform"}, labels)
+
+	m = CortexCloudProviderModel{
+		ConfigFile: types.StringValue(configFile),
+		Profile:    types.StringValue("team-x"),
+	}
+	m.ParseConfigFile(context.Background(), &diags)
+	require.False(t, diags.HasError(), "unexpected errors: %v", diags.Errors())
+
+	labels = nil
+	require.False(t, m.DefaultLabels.ElementsAs(context.Background(), &labels, false).HasError())
+	assert.ElementsMatch(t, []string{"managed-by:terraform", "team:x"}, labels, "duplicate labels should be removed")
+}
+
+// TestConfigFileExternalChanges verifies that the external change detection
+// values are read from the config file, and that the environment variables
+// take precedence over them
+func TestConfigFileExternalChanges(t *testing.T) {
+	dir := t.TempDir()
+	configFile := writeTempFile(t, dir, "config.json", `{
+		"detect_external_changes": true,
+		"api_key_identity": "file-identity"
+	}`)
+	t.Setenv(APIKeyIdentityEnvVar, "env-identity")
+
+	var diags diag.Diagnostics
+	m := CortexCloudProviderModel{ConfigFile: types.StringValue(configFile)}
+	m.ParseConfigFile(context.Background(), &diags)
+	m.ParseEnvVars(context.Background(), &diags)
+	require.False(t, diags.HasError(), "unexpected errors: %v", diags.Errors())
+
+	assert.True(t, m.DetectExternalChanges.ValueBool())
+	assert.Equal(t, "env-identity", m.APIKeyIdentity.ValueString())
+}
+
+// writeTempFile is a helper function to write a file with the given name and
+// content to the specified directory
+func writeTempFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	filePath := filepath.Join(dir, name)
+	if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write temp file: %v", err)
+	}
+
+	return filePath
+}
@@ -0,0 +1,58 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// SDKClient is an SDK client that is initialized on first use. The result of
+// the initialization, including any error, is memoized and shared by every
+// caller, and concurrent callers wait for a single initialization.
+type SDKClient[C any] struct {
+	name   string
+	init   func(ctx context.Context) (C, error)
+	once   sync.Once
+	client C
+	err    error
+}
+
+// NewSDKClient returns an SDKClient that calls init to initialize the client
+// the first time it is requested, using the context of the caller that
+// requested it. The name of the client's API domain is included in the
+// error diagnostic if initialization fails.
+func NewSDKClient[C any](name string, init func(ctx context.Context) (C, error)) *SDKClient[C] {
+	return &SDKClient[C]{
+		name: name,
+		init: init,
+	}
+}
+
+// Get returns the client, initializing it if it has not been initialized
+// yet.
+func (c *SDKClient[C]) Get(ctx context.Context) (C, error) {
+	c.once.Do(func() {
+		c.client, c.err = c.init(ctx)
+		if c.err != nil {
+			c.err = fmt.Errorf("error initializing %s client: %w", c.name, c.err)
+		}
+	})
+
+	return c.client, c.err
+}
+
+// Client returns the client, initializing it if it has not been initialized
+// yet. If initialization fails, an error is added to diagnostics.
+func (c *SDKClient[C]) Client(ctx context.Context, diagnostics *diag.Diagnostics) C {
+	client, err := c.Get(ctx)
+	if err != nil {
+		diagnostics.AddError("Cortex Cloud API Setup Error", err.Error())
+	}
+
+	return client
+}
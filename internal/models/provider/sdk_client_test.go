@@ -0,0 +1,105 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSDKClient struct {
+	id int32
+}
+
+// TestSDKClient_Memoized verifies that the client is initialized exactly
+// once, even when requested concurrently, and that every caller receives the
+// same client
+func TestSDKClient_Memoized(t *testing.T) {
+	var initCount atomic.Int32
+	client := NewSDKClient("test", func(context.Context) (*testSDKClient, error) {
+		return &testSDKClient{id: initCount.Add(1)}, nil
+	})
+
+	assert.Equal(t, int32(0), initCount.Load(), "client should not be initialized before first use")
+
+	const callers = 50
+	results := make([]*testSDKClient, callers)
+
+	var wg sync.WaitGroup
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c, err := client.Get(context.Background())
+			assert.NoError(t, err)
+			results[i] = c
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), initCount.Load())
+	for _, result := range results {
+		require.NotNil(t, result)
+		assert.Same(t, results[0], result)
+	}
+}
+
+// TestSDKClient_Error verifies that initialization errors are memoized and
+// reported as diagnostics by Client
+func TestSDKClient_Error(t *testing.T) {
+	var initCount atomic.Int32
+	client := NewSDKClient("test", func(context.Context) (*testSDKClient, error) {
+		initCount.Add(1)
+		return nil, errors.New("invalid configuration")
+	})
+
+	_, err := client.Get(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error initializing test client: invalid configuration")
+
+	var diags diag.Diagnostics
+	assert.Nil(t, client.Client(ctx, &diags))
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, "Cortex Cloud API Setup Error", diags.Errors()[0].Summary())
+
+	assert.Equal(t, int32(1), initCount.Load(), "failed initialization should not be retried")
+}
+
+// TestSDKClient_Client verifies that Client does not add diagnostics when
+// the client is initialized successfully
+func TestSDKClient_Client(t *testing.T) {
+	client := NewSDKClient("test", func(context.Context) (*testSDKClient, error) {
+		return &testSDKClient{id: 1}, nil
+	})
+
+	var diags diag.Diagnostics
+	c := client.Client(ctx, &diags)
+	assert.False(t, diags.HasError())
+	require.NotNil(t, c)
+	assert.Equal(t, int32(1), c.id)
+}
+
+// TestSDKClient_Context verifies that the client is initialized using the
+// context of the first caller that requests it
+func TestSDKClient_Context(t *testing.T) {
+	type contextKey struct{}
+
+	var initValue any
+	client := NewSDKClient("test", func(ctx context.Context) (*testSDKClient, error) {
+		initValue = ctx.Value(contextKey{})
+		return &testSDKClient{id: 1}, nil
+	})
+
+	ctx := context.WithValue(context.Background(), contextKey{}, "caller")
+	_, err := client.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "caller", initValue)
+}
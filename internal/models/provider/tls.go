@@ -0,0 +1,168 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// pemBlockPrefix is used to distinguish PEM-encoded values from file paths
+// in the attributes that accept either.
+const pemBlockPrefix = "-----BEGIN"
+
+// Proxy returns the parsed `proxy_url` value, or nil if no proxy is
+// configured.
+func (m *CortexCloudProviderModel) Proxy() (*url.URL, error) {
+	if !isSet(m.ProxyURL) {
+		return nil, nil
+	}
+
+	proxyURL, err := url.Parse(m.ProxyURL.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf(`unsupported scheme "%s", expected one of: http, https, socks5`, proxyURL.Scheme)
+	}
+	if proxyURL.Host == "" {
+		return nil, errors.New("missing host")
+	}
+
+	return proxyURL, nil
+}
+
+// TLSConfig returns the TLS configuration built from the `ca_cert_file`,
+// `ca_cert_pem`, `client_cert` and `client_key` values, or nil if none of
+// them are configured. The configured CA certificates are trusted in
+// addition to the system's root CA certificates.
+func (m *CortexCloudProviderModel) TLSConfig() (*tls.Config, error) {
+	rootCAs, err := m.rootCAs()
+	if err != nil {
+		return nil, err
+	}
+
+	clientCert, err := m.clientCertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	if rootCAs == nil && clientCert == nil {
+		return nil, nil
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    rootCAs,
+	}
+	if clientCert != nil {
+		config.Certificates = []tls.Certificate{*clientCert}
+	}
+
+	return config, nil
+}
+
+// rootCAs returns the system's root CA certificates along with the
+// certificates from `ca_cert_file` and `ca_cert_pem`, or nil if neither is
+// configured.
+func (m *CortexCloudProviderModel) rootCAs() (*x509.CertPool, error) {
+	if !isSet(m.CACertFile) && !isSet(m.CACertPEM) {
+		return nil, nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	if isSet(m.CACertFile) {
+		data, err := os.ReadFile(filepath.Clean(m.CACertFile.ValueString()))
+		if err != nil {
+			return nil, &TLSAttributeError{Attribute: "ca_cert_file", Err: err}
+		}
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, &TLSAttributeError{Attribute: "ca_cert_file", Err: errors.New("no PEM-encoded certificates found")}
+		}
+	}
+
+	if isSet(m.CACertPEM) {
+		if !pool.AppendCertsFromPEM([]byte(m.CACertPEM.ValueString())) {
+			return nil, &TLSAttributeError{Attribute: "ca_cert_pem", Err: errors.New("no PEM-encoded certificates found")}
+		}
+	}
+
+	return pool, nil
+}
+
+// clientCertificate returns the client certificate for mutual TLS
+// authentication, or nil if neither `client_cert` nor `client_key` is
+// configured.
+func (m *CortexCloudProviderModel) clientCertificate() (*tls.Certificate, error) {
+	if !isSet(m.ClientCert) && !isSet(m.ClientKey) {
+		return nil, nil
+	}
+	if !isSet(m.ClientCert) {
+		return nil, &TLSAttributeError{Attribute: "client_cert", Err: errors.New("client_cert must be configured when client_key is configured")}
+	}
+	if !isSet(m.ClientKey) {
+		return nil, &TLSAttributeError{Attribute: "client_key", Err: errors.New("client_key must be configured when client_cert is configured")}
+	}
+
+	certPEM, err := readPEMOrFile(m.ClientCert.ValueString())
+	if err != nil {
+		return nil, &TLSAttributeError{Attribute: "client_cert", Err: err}
+	}
+	keyPEM, err := readPEMOrFile(m.ClientKey.ValueString())
+	if err != nil {
+		return nil, &TLSAttributeError{Attribute: "client_key", Err: err}
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, &TLSAttributeError{Attribute: "client_cert", Err: err}
+	}
+
+	return &cert, nil
+}
+
+// TLSAttributeError is returned by TLSConfig when the value of one of the
+// TLS attributes cannot be used.
+type TLSAttributeError struct {
+	Attribute string
+	Err       error
+}
+
+func (e *TLSAttributeError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Attribute, e.Err.Error())
+}
+
+func (e *TLSAttributeError) Unwrap() error {
+	return e.Err
+}
+
+// readPEMOrFile returns the given value if it contains PEM-encoded data, or
+// otherwise the contents of the file at the path it contains.
+func readPEMOrFile(value string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), pemBlockPrefix) {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(filepath.Clean(value))
+}
+
+// isSet returns true if the given value is known and not empty.
+func isSet(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown() && value.ValueString() != ""
+}
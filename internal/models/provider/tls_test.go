@@ -0,0 +1,203 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// generateClientCertificate is a helper function to generate a self-signed
+// client certificate and private key, returned as PEM-encoded strings
+func generateClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-cortexcloud-test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return string(certPEM), string(keyPEM)
+}
+
+func TestValidate_TLS(t *testing.T) {
+	dir := t.TempDir()
+	certPEM, keyPEM := generateClientCertificate(t)
+	certFile := writeTempFile(t, dir, "client.crt", certPEM)
+	keyFile := writeTempFile(t, dir, "client.key", keyPEM)
+	invalidFile := writeTempFile(t, dir, "invalid.pem", "not a certificate")
+
+	baseModel := func() CortexCloudProviderModel {
+		return CortexCloudProviderModel{
+			APIURL:   types.StringValue("https://api.example.com"),
+			APIKey:   types.StringValue("test-key"),
+			APIKeyID: types.Int32Value(1),
+		}
+	}
+
+	t.Run("valid files are accepted", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := baseModel()
+		m.ProxyURL = types.StringValue("http://proxy.example.com:3128")
+		m.CACertFile = types.StringValue(certFile)
+		m.ClientCert = types.StringValue(certFile)
+		m.ClientKey = types.StringValue(keyFile)
+		m.Validate(context.Background(), &diags)
+		assert.False(t, diags.HasError(), "expected no error for valid files, got: %v", diags.Errors())
+	})
+
+	t.Run("PEM-encoded values are accepted", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := baseModel()
+		m.CACertPEM = types.StringValue(certPEM)
+		m.ClientCert = types.StringValue(certPEM)
+		m.ClientKey = types.StringValue(keyPEM)
+		m.Validate(context.Background(), &diags)
+		assert.False(t, diags.HasError(), "expected no error for PEM-encoded values, got: %v", diags.Errors())
+	})
+
+	t.Run("missing and invalid files produce diagnostics", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := baseModel()
+		m.CACertFile = types.StringValue(invalidFile)
+		m.ClientCert = types.StringValue(certFile)
+		m.ClientKey = types.StringValue(dir + "/missing.key")
+		m.Validate(context.Background(), &diags)
+		assert.Equal(t, 2, diags.ErrorsCount(), "expected a diagnostic error for the CA certificate and the client key, got: %v", diags.Errors())
+	})
+
+	t.Run("client certificate without key produces diagnostic", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := baseModel()
+		m.ClientCert = types.StringValue(certFile)
+		m.Validate(context.Background(), &diags)
+		require.Equal(t, 1, diags.ErrorsCount(), "expected a diagnostic error for the missing client key, got: %v", diags.Errors())
+		assert.Equal(t, "Invalid TLS Configuration", diags.Errors()[0].Summary())
+	})
+
+	t.Run("invalid proxy URL produces diagnostic", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := baseModel()
+		m.ProxyURL = types.StringValue("ftp://proxy.example.com")
+		m.Validate(context.Background(), &diags)
+		require.Equal(t, 1, diags.ErrorsCount(), "expected a diagnostic error for the proxy URL, got: %v", diags.Errors())
+		assert.Equal(t, "Invalid Proxy URL", diags.Errors()[0].Summary())
+	})
+}
+
+// TestTLSConfig_MutualTLS verifies that the TLS configuration trusts the
+// configured CA certificate and presents the configured client certificate
+func TestTLSConfig_MutualTLS(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	serverCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	certPEM, keyPEM := generateClientCertificate(t)
+
+	m := CortexCloudProviderModel{
+		CACertPEM:  types.StringValue(string(serverCertPEM)),
+		ClientCert: types.StringValue(certPEM),
+		ClientKey:  types.StringValue(keyPEM),
+	}
+	tlsConfig, err := m.TLSConfig()
+	require.NoError(t, err)
+	require.NotNil(t, tlsConfig)
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Without the CA certificate, the server's certificate is not trusted
+	m.CACertPEM = types.StringNull()
+	tlsConfig, err = m.TLSConfig()
+	require.NoError(t, err)
+	transport = http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	client = &http.Client{Transport: transport}
+
+	_, err = client.Get(server.URL) //nolint:bodyclose
+	assert.Error(t, err)
+}
+
+// TestTLSConfig_Unset verifies that no TLS configuration or proxy is
+// returned when none of their attributes are configured
+func TestTLSConfig_Unset(t *testing.T) {
+	m := CortexCloudProviderModel{}
+
+	tlsConfig, err := m.TLSConfig()
+	require.NoError(t, err)
+	assert.Nil(t, tlsConfig)
+
+	proxyURL, err := m.Proxy()
+	require.NoError(t, err)
+	assert.Nil(t, proxyURL)
+}
+
+// TestConfigFileTLS verifies that the proxy and TLS values are read from the
+// config file, and that the environment variables take precedence over them
+func TestConfigFileTLS(t *testing.T) {
+	dir := t.TempDir()
+	configFile := writeTempFile(t, dir, "config.yaml", `
+proxy_url: http://file-proxy.example.com:3128
+ca_cert_file: /etc/ssl/file-ca.pem
+client_cert: /etc/cortexcloud/client.crt
+client_key: /etc/cortexcloud/client.key
+`)
+	t.Setenv(ProxyURLEnvVar, "http://env-proxy.example.com:3128")
+
+	var diags diag.Diagnostics
+	m := CortexCloudProviderModel{ConfigFile: types.StringValue(configFile)}
+	m.ParseConfigFile(context.Background(), &diags)
+	m.ParseEnvVars(context.Background(), &diags)
+	require.False(t, diags.HasError(), "unexpected errors: %v", diags.Errors())
+
+	assert.Equal(t, "http://env-proxy.example.com:3128", m.ProxyURL.ValueString())
+	assert.Equal(t, "/etc/ssl/file-ca.pem", m.CACertFile.ValueString())
+	assert.Equal(t, "/etc/cortexcloud/client.crt", m.ClientCert.ValueString())
+	assert.Equal(t, "/etc/cortexcloud/client.key", m.ClientKey.ValueString())
+}
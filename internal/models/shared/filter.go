@@ -6,6 +6,7 @@ import (
 
 	filterTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/filter"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -121,7 +122,14 @@ var (
 			},
 		},
 	}
-	RootFilterWithNullChildren = types.ObjectValueMust(
+	// RootFilterDataSourceAttributes is the data source equivalent of
+	// RootFilterAttributes, used for filters configured on data sources.
+	RootFilterDataSourceAttributes = getRootDataSourceFilterSchema(false)
+	// RootFilterComputedDataSourceAttributes is the read-only variant of
+	// RootFilterDataSourceAttributes, used for filters returned by data
+	// sources.
+	RootFilterComputedDataSourceAttributes = getRootDataSourceFilterSchema(true)
+	RootFilterWithNullChildren             = types.ObjectValueMust(
 		RootFilterAttrTypeMap,
 		map[string]attr.Value{
 			"and": types.ListValueMust(
@@ -187,6 +195,66 @@ func GetRecursiveFilterSchema(depth, maxDepth int) map[string]schema.Attribute {
 	return attrs
 }
 
+// GetRecursiveDataSourceFilterSchema is the data source equivalent of
+// GetRecursiveFilterSchema. If computed is true, the attributes are
+// read-only.
+func GetRecursiveDataSourceFilterSchema(depth, maxDepth int, computed bool) map[string]datasourceSchema.Attribute {
+	attrs := map[string]datasourceSchema.Attribute{
+		"search_field": datasourceSchema.StringAttribute{
+			Optional: !computed,
+			Computed: computed,
+		},
+		"search_type": datasourceSchema.StringAttribute{
+			Optional: !computed,
+			Computed: computed,
+		},
+		"search_value": datasourceSchema.StringAttribute{
+			Optional: !computed,
+			Computed: computed,
+		},
+	}
+
+	if depth < maxDepth {
+		attrs["and"] = datasourceSchema.ListNestedAttribute{
+			Optional: !computed,
+			Computed: computed,
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: GetRecursiveDataSourceFilterSchema(depth+1, maxDepth, computed),
+			},
+		}
+		attrs["or"] = datasourceSchema.ListNestedAttribute{
+			Optional: !computed,
+			Computed: computed,
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: GetRecursiveDataSourceFilterSchema(depth+1, maxDepth, computed),
+			},
+		}
+	}
+
+	return attrs
+}
+
+// getRootDataSourceFilterSchema returns the data source schema for the
+// "and" and "or" attributes of a filter root.
+func getRootDataSourceFilterSchema(computed bool) map[string]datasourceSchema.Attribute {
+	return map[string]datasourceSchema.Attribute{
+		"and": datasourceSchema.ListNestedAttribute{
+			Optional: !computed,
+			Computed: computed,
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: GetRecursiveDataSourceFilterSchema(0, MaxFilterDepth, computed),
+			},
+		},
+		"or": datasourceSchema.ListNestedAttribute{
+			Optional: !computed,
+			Computed: computed,
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: GetRecursiveDataSourceFilterSchema(0, MaxFilterDepth, computed),
+			},
+		},
+	}
+}
+
 func GetRecursiveFilterAttrType(depth, maxDepth int) map[string]attr.Type {
 	attrs := map[string]attr.Type{
 		"search_field": types.StringType,
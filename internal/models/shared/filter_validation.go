@@ -0,0 +1,89 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FilterSearchValidator validates the search condition of a single filter
+// node. It is only called for nodes that configure a search condition.
+type FilterSearchValidator func(attrPath path.Path, filter NestedFilterModel, diags *diag.Diagnostics)
+
+// Validate checks the structure of the filter configured at attrPath. Every
+// nested filter must either group other filters using "and" or "or", or
+// define a search condition using "search_field", "search_type" and
+// "search_value". Each search condition is additionally passed to
+// validateSearch, if provided.
+func (m *RootFilterModel) Validate(attrPath path.Path, diags *diag.Diagnostics, validateSearch FilterSearchValidator) {
+	if m == nil {
+		return
+	}
+
+	validateNestedFilters(attrPath.AtName("and"), m.And, diags, validateSearch)
+	validateNestedFilters(attrPath.AtName("or"), m.Or, diags, validateSearch)
+}
+
+// validateNestedFilters validates each filter in the list configured at
+// attrPath.
+func validateNestedFilters(attrPath path.Path, filters []NestedFilterModel, diags *diag.Diagnostics, validateSearch FilterSearchValidator) {
+	for i, filter := range filters {
+		filter.validate(attrPath.AtListIndex(i), diags, validateSearch)
+	}
+}
+
+// validate checks that the filter either groups other filters or defines a
+// complete search condition, but not both.
+func (m NestedFilterModel) validate(attrPath path.Path, diags *diag.Diagnostics, validateSearch FilterSearchValidator) {
+	isGroup := len(m.And) > 0 || len(m.Or) > 0
+	isSearch := !m.SearchField.IsNull() || !m.SearchType.IsNull() || !m.SearchValue.IsNull()
+
+	switch {
+	case len(m.And) > 0 && len(m.Or) > 0:
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid Filter",
+			"Only one of \"and\" or \"or\" may be configured in a single filter. Nest the filters to combine both operators.",
+		)
+	case isGroup && isSearch:
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid Filter",
+			"A filter must either group other filters using \"and\" or \"or\", or define a search condition using \"search_field\", \"search_type\" and \"search_value\", but not both.",
+		)
+	case !isGroup && !isSearch:
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid Filter",
+			"A filter must either group other filters using \"and\" or \"or\", or define a search condition using \"search_field\", \"search_type\" and \"search_value\".",
+		)
+	case isSearch:
+		missing := false
+		for _, attribute := range []struct {
+			name  string
+			value types.String
+		}{
+			{"search_field", m.SearchField},
+			{"search_type", m.SearchType},
+			{"search_value", m.SearchValue},
+		} {
+			if attribute.value.IsNull() {
+				missing = true
+				diags.AddAttributeError(
+					attrPath.AtName(attribute.name),
+					"Missing Filter Attribute",
+					fmt.Sprintf("The %q attribute must be configured when defining a search condition.", attribute.name),
+				)
+			}
+		}
+
+		if !missing && validateSearch != nil {
+			validateSearch(attrPath, m, diags)
+		}
+	}
+
+	validateNestedFilters(attrPath.AtName("and"), m.And, diags, validateSearch)
+	validateNestedFilters(attrPath.AtName("or"), m.Or, diags, validateSearch)
+}
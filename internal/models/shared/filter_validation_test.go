@@ -0,0 +1,166 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func searchFilter(field, searchType, value string) NestedFilterModel {
+	return NestedFilterModel{
+		SearchField: types.StringValue(field),
+		SearchType:  types.StringValue(searchType),
+		SearchValue: types.StringValue(value),
+	}
+}
+
+func TestRootFilterModel_Validate_Nil(t *testing.T) {
+	var m *RootFilterModel
+	var diags diag.Diagnostics
+	m.Validate(path.Root("filter"), &diags, nil)
+	if diags.HasError() {
+		t.Errorf("expected no errors for nil filter, got %v", diags)
+	}
+}
+
+func TestRootFilterModel_Validate_Valid(t *testing.T) {
+	m := RootFilterModel{
+		And: []NestedFilterModel{
+			searchFilter("SEVERITY", "EQ", "HIGH"),
+			{
+				Or: []NestedFilterModel{
+					searchFilter("ASSET_TAG", "CONTAINS", "test"),
+					searchFilter("ASSET_TAG", "CONTAINS", "dev"),
+				},
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+	m.Validate(path.Root("filter"), &diags, nil)
+	if diags.HasError() {
+		t.Errorf("expected no errors, got %v", diags)
+	}
+}
+
+func TestRootFilterModel_Validate_Empty(t *testing.T) {
+	m := RootFilterModel{
+		And: []NestedFilterModel{{}},
+	}
+
+	var diags diag.Diagnostics
+	m.Validate(path.Root("filter"), &diags, nil)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %v", diags)
+	}
+	if p := diags.Errors()[0].(diag.DiagnosticWithPath).Path(); !p.Equal(path.Root("filter").AtName("and").AtListIndex(0)) {
+		t.Errorf("unexpected error path: %s", p)
+	}
+}
+
+func TestRootFilterModel_Validate_GroupAndSearch(t *testing.T) {
+	child := searchFilter("SEVERITY", "EQ", "HIGH")
+	child.And = []NestedFilterModel{searchFilter("SEVERITY", "EQ", "LOW")}
+	m := RootFilterModel{Or: []NestedFilterModel{child}}
+
+	var diags diag.Diagnostics
+	m.Validate(path.Root("filter"), &diags, nil)
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("expected 1 error, got %v", diags)
+	}
+}
+
+func TestRootFilterModel_Validate_AndWithOr(t *testing.T) {
+	m := RootFilterModel{
+		And: []NestedFilterModel{
+			{
+				And: []NestedFilterModel{searchFilter("SEVERITY", "EQ", "HIGH")},
+				Or:  []NestedFilterModel{searchFilter("SEVERITY", "EQ", "LOW")},
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+	m.Validate(path.Root("filter"), &diags, nil)
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("expected 1 error, got %v", diags)
+	}
+}
+
+func TestRootFilterModel_Validate_MissingSearchAttributes(t *testing.T) {
+	m := RootFilterModel{
+		And: []NestedFilterModel{
+			{
+				SearchField: types.StringValue("SEVERITY"),
+				SearchType:  types.StringNull(),
+				SearchValue: types.StringNull(),
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+	m.Validate(path.Root("filter"), &diags, func(path.Path, NestedFilterModel, *diag.Diagnostics) {
+		t.Error("expected incomplete search condition not to be passed to the validator")
+	})
+	if diags.ErrorsCount() != 2 {
+		t.Errorf("expected 2 errors, got %v", diags)
+	}
+}
+
+func TestRootFilterModel_Validate_UnknownSearchAttributes(t *testing.T) {
+	m := RootFilterModel{
+		And: []NestedFilterModel{
+			{
+				SearchField: types.StringValue("SEVERITY"),
+				SearchType:  types.StringValue("EQ"),
+				SearchValue: types.StringUnknown(),
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+	m.Validate(path.Root("filter"), &diags, nil)
+	if diags.HasError() {
+		t.Errorf("expected no errors for unknown search value, got %v", diags)
+	}
+}
+
+func TestRootFilterModel_Validate_SearchValidator(t *testing.T) {
+	m := RootFilterModel{
+		And: []NestedFilterModel{
+			searchFilter("SEVERITY", "EQ", "HIGH"),
+			{
+				Or: []NestedFilterModel{
+					searchFilter("SEVERITY", "NOPE", "LOW"),
+				},
+			},
+		},
+	}
+
+	var paths []path.Path
+	var diags diag.Diagnostics
+	m.Validate(path.Root("filter"), &diags, func(p path.Path, filter NestedFilterModel, diags *diag.Diagnostics) {
+		paths = append(paths, p)
+		if filter.SearchType.ValueString() != "EQ" {
+			diags.AddAttributeError(p.AtName("search_type"), "Invalid", "invalid")
+		}
+	})
+
+	expected := []path.Path{
+		path.Root("filter").AtName("and").AtListIndex(0),
+		path.Root("filter").AtName("and").AtListIndex(1).AtName("or").AtListIndex(0),
+	}
+	if len(paths) != len(expected) {
+		t.Fatalf("expected validator to be called %d times, got %d", len(expected), len(paths))
+	}
+	for i := range expected {
+		if !paths[i].Equal(expected[i]) {
+			t.Errorf("expected path %s, got %s", expected[i], paths[i])
+		}
+	}
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("expected 1 error, got %v", diags)
+	}
+}
@@ -7,33 +7,84 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
+	"strings"
 
 	vulnerabilityTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/vulnerability"
+	sharedModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/shared"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// Search types supported by the match and exclusion criteria of
+// vulnerability policies, grouped by the kind of value of the search field.
+var (
+	policyCriteriaTextSearchTypes    = []string{"EQ", "NEQ", "CONTAINS", "NCONTAINS", "WILDCARD"}
+	policyCriteriaEnumSearchTypes    = []string{"EQ", "NEQ", "IN", "NIN"}
+	policyCriteriaNumberSearchTypes  = []string{"EQ", "NEQ", "GT", "GTE", "LT", "LTE"}
+	policyCriteriaBooleanSearchTypes = []string{"EQ"}
+)
+
+// PolicyCriteriaSearchFields maps the search fields that may be used in the
+// match and exclusion criteria of a vulnerability policy to the search types
+// supported by each field. The search types of each field are those of the
+// kind of value it holds (text, enumeration, number or boolean).
+//
+// Criteria using other search fields can still be configured using the
+// match_criteria and exclusion_criteria attributes.
+var PolicyCriteriaSearchFields = map[string][]string{
+	"ASSET_NAME":    policyCriteriaTextSearchTypes,
+	"ASSET_TAG":     policyCriteriaTextSearchTypes,
+	"ASSET_TYPE":    policyCriteriaEnumSearchTypes,
+	"CVE_ID":        policyCriteriaTextSearchTypes,
+	"CVSS_SCORE":    policyCriteriaNumberSearchTypes,
+	"EPSS_SCORE":    policyCriteriaNumberSearchTypes,
+	"FIX_AVAILABLE": policyCriteriaBooleanSearchTypes,
+	"HAS_KEV":       policyCriteriaBooleanSearchTypes,
+	"PACKAGE_NAME":  policyCriteriaTextSearchTypes,
+	"SEVERITY":      policyCriteriaEnumSearchTypes,
+}
+
+// PolicyCriteriaFilterDescription returns the given description of a
+// criteria filter attribute, followed by the supported search fields and
+// the search types supported by each field.
+func PolicyCriteriaFilterDescription(description string) string {
+	fields := make([]string, 0, len(PolicyCriteriaSearchFields))
+	for field, searchTypes := range PolicyCriteriaSearchFields {
+		fields = append(fields, fmt.Sprintf("\"%s\" (\"%s\")", field, strings.Join(searchTypes, `", "`)))
+	}
+	slices.Sort(fields)
+
+	return fmt.Sprintf("%s Supported search fields and their search types are: %s.", description, strings.Join(fields, ", "))
+}
+
 // PolicyModel is the Terraform model for a vulnerability management policy.
 type PolicyModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Name                types.String `tfsdk:"name"`
-	Description         types.String `tfsdk:"description"`
-	Priority            types.Int64  `tfsdk:"priority"`
-	Status              types.String `tfsdk:"status"`
-	MatchCriteria       types.String `tfsdk:"match_criteria"`
-	ExclusionCriteria   types.String `tfsdk:"exclusion_criteria"`
-	Action              types.List   `tfsdk:"action"`
-	ActionCategory      types.String `tfsdk:"action_category"`
-	Severity            types.String `tfsdk:"severity"`
-	AssetGroupScope     types.List   `tfsdk:"asset_group_scope"`
-	PolicyType          types.String `tfsdk:"policy_type"`
-	OpenIssues          types.Int64  `tfsdk:"open_issues"`
-	EstimatedMatchCount types.Int64  `tfsdk:"estimated_match_count"`
-	ModifiedBy          types.String `tfsdk:"modified_by"`
-	ModifiedTimestamp   types.String `tfsdk:"modified_timestamp"`
+	ID                      types.String        `tfsdk:"id"`
+	Name                    types.String        `tfsdk:"name"`
+	Description             types.String        `tfsdk:"description"`
+	Priority                types.Int64         `tfsdk:"priority"`
+	Status                  types.String        `tfsdk:"status"`
+	MatchCriteria           util.NormalizedJSON `tfsdk:"match_criteria"`
+	MatchCriteriaFilter     types.Object        `tfsdk:"match_criteria_filter"`
+	ExclusionCriteria       util.NormalizedJSON `tfsdk:"exclusion_criteria"`
+	ExclusionCriteriaFilter types.Object        `tfsdk:"exclusion_criteria_filter"`
+	Action                  types.List          `tfsdk:"action"`
+	ActionCategory          types.String        `tfsdk:"action_category"`
+	Severity                types.String        `tfsdk:"severity"`
+	AssetGroupScope         types.List          `tfsdk:"asset_group_scope"`
+	PolicyType              types.String        `tfsdk:"policy_type"`
+	OpenIssues              types.Int64         `tfsdk:"open_issues"`
+	EstimatedMatchCount     types.Int64         `tfsdk:"estimated_match_count"`
+	ModifiedBy              types.String        `tfsdk:"modified_by"`
+	ModifiedTimestamp       types.String        `tfsdk:"modified_timestamp"`
 }
 
 // ActionModel represents a policy action.
@@ -150,68 +201,46 @@ func (m *PolicyModel) RefreshFromRemote(ctx context.Context, diags *diag.Diagnos
 	m.OpenIssues = types.Int64Value(int64(remote.OPEN_ISSUES))
 	m.EstimatedMatchCount = types.Int64Value(int64(remote.ESTIMATED_MATCH_COUNT))
 
-	// Convert match_criteria to JSON string
-	// Normalize SEARCH_VALUE fields to strings to avoid type mismatch with API responses.
-	// The API may return numeric SEARCH_VALUE fields (e.g., CVSS_SCORE) as numbers
-	// instead of strings, causing Terraform to detect an inconsistent result after apply.
-	// We preserve the plan/state value if the API response is semantically equivalent
-	// (e.g., "7.0" in plan vs 7 from API both represent the same numeric value).
+	// Convert match_criteria and exclusion_criteria to JSON strings. The
+	// API may return numeric search values (e.g. of CVSS_SCORE) as numbers
+	// rather than strings, in which case the framework keeps the planned or
+	// prior value, since the documents are semantically equal.
 	if remote.MATCH_CRITERIA != nil {
-		normalizeSearchValues(remote.MATCH_CRITERIA)
 		matchCriteriaJSON, err := json.Marshal(remote.MATCH_CRITERIA)
 		if err != nil {
 			diags.AddError("Error Marshaling Match Criteria", err.Error())
 			return
 		}
-		remoteStr := string(matchCriteriaJSON)
-
-		// If we already have a plan/state value, check if it's semantically equivalent
-		// to the API response. If so, preserve the plan value to avoid spurious diffs.
-		if !m.MatchCriteria.IsNull() && !m.MatchCriteria.IsUnknown() {
-			if matchCriteriaJSONEqual(m.MatchCriteria.ValueString(), remoteStr) {
-				// Keep existing plan/state value — semantically equivalent
-				tflog.Debug(ctx, "match_criteria: preserving plan value (semantically equivalent to API response)")
-			} else {
-				m.MatchCriteria = types.StringValue(remoteStr)
-			}
-		} else {
-			m.MatchCriteria = types.StringValue(remoteStr)
-		}
+		m.MatchCriteria = util.NewNormalizedJSONValue(string(matchCriteriaJSON))
 	} else {
-		m.MatchCriteria = types.StringNull()
+		m.MatchCriteria = util.NewNormalizedJSONNull()
 	}
 
-	// Convert exclusion_criteria to JSON string
 	// Note: API may return null even when empty object {} was sent.
-	// Normalize SEARCH_VALUE fields the same way as match_criteria above.
 	if remote.EXCLUSIONS != nil {
-		normalizeSearchValues(remote.EXCLUSIONS)
 		exclusionsJSON, err := json.Marshal(remote.EXCLUSIONS)
 		if err != nil {
 			diags.AddError("Error Marshaling Exclusion Criteria", err.Error())
 			return
 		}
-		remoteExclusionStr := string(exclusionsJSON)
-
-		// If we already have a plan/state value, check if it's semantically equivalent
-		// to the API response. If so, preserve the plan value to avoid spurious diffs.
-		if !m.ExclusionCriteria.IsNull() && !m.ExclusionCriteria.IsUnknown() {
-			if matchCriteriaJSONEqual(m.ExclusionCriteria.ValueString(), remoteExclusionStr) {
-				tflog.Debug(ctx, "exclusion_criteria: preserving plan value (semantically equivalent to API response)")
-			} else {
-				m.ExclusionCriteria = types.StringValue(remoteExclusionStr)
-			}
-		} else {
-			m.ExclusionCriteria = types.StringValue(remoteExclusionStr)
-		}
+		m.ExclusionCriteria = util.NewNormalizedJSONValue(string(exclusionsJSON))
 	} else {
 		// Only set to null if we don't already have a value (API bug workaround)
 		if m.ExclusionCriteria.IsNull() || m.ExclusionCriteria.IsUnknown() {
-			m.ExclusionCriteria = types.StringNull()
+			m.ExclusionCriteria = util.NewNormalizedJSONNull()
 		}
 		// Otherwise keep existing value
 	}
 
+	// Convert match_criteria and exclusion_criteria to typed filters. The
+	// existing value is preserved if it is semantically equivalent to the
+	// API response, and an existing exclusion criteria filter is kept if the
+	// API returns null.
+	m.MatchCriteriaFilter = refreshCriteriaFilter(ctx, m.MatchCriteriaFilter, remote.MATCH_CRITERIA)
+	if remote.EXCLUSIONS != nil || m.ExclusionCriteriaFilter.IsNull() || m.ExclusionCriteriaFilter.IsUnknown() {
+		m.ExclusionCriteriaFilter = refreshCriteriaFilter(ctx, m.ExclusionCriteriaFilter, remote.EXCLUSIONS)
+	}
+
 	// Convert actions list
 	if len(remote.ACTION) == 0 {
 		m.Action = types.ListNull(types.ObjectType{
@@ -327,24 +356,11 @@ func (m *PolicyModel) ToCreateRequest(ctx context.Context, diags *diag.Diagnosti
 		PolicyType:     m.PolicyType.ValueString(),
 	}
 
-	// Parse match_criteria JSON
-	if !m.MatchCriteria.IsNull() && !m.MatchCriteria.IsUnknown() {
-		var matchCriteria map[string]interface{}
-		if err := json.Unmarshal([]byte(m.MatchCriteria.ValueString()), &matchCriteria); err != nil {
-			diags.AddError("Error Parsing Match Criteria", err.Error())
-			return req
-		}
-		req.MatchCriteria = matchCriteria
-	}
-
-	// Parse exclusion_criteria JSON
-	if !m.ExclusionCriteria.IsNull() && !m.ExclusionCriteria.IsUnknown() {
-		var exclusionCriteria map[string]interface{}
-		if err := json.Unmarshal([]byte(m.ExclusionCriteria.ValueString()), &exclusionCriteria); err != nil {
-			diags.AddError("Error Parsing Exclusion Criteria", err.Error())
-			return req
-		}
-		req.ExclusionCriteria = exclusionCriteria
+	// Convert match_criteria and exclusion_criteria
+	req.MatchCriteria = m.toCriteria(ctx, diags, "Match Criteria", m.MatchCriteria, m.MatchCriteriaFilter)
+	req.ExclusionCriteria = m.toCriteria(ctx, diags, "Exclusion Criteria", m.ExclusionCriteria, m.ExclusionCriteriaFilter)
+	if diags.HasError() {
+		return req
 	}
 
 	// Convert actions
@@ -399,24 +415,11 @@ func (m *PolicyModel) ToUpdateRequest(ctx context.Context, diags *diag.Diagnosti
 		PolicyType:     m.PolicyType.ValueString(),
 	}
 
-	// Parse match_criteria JSON
-	if !m.MatchCriteria.IsNull() && !m.MatchCriteria.IsUnknown() {
-		var matchCriteria map[string]interface{}
-		if err := json.Unmarshal([]byte(m.MatchCriteria.ValueString()), &matchCriteria); err != nil {
-			diags.AddError("Error Parsing Match Criteria", err.Error())
-			return req
-		}
-		req.MatchCriteria = matchCriteria
-	}
-
-	// Parse exclusion_criteria JSON
-	if !m.ExclusionCriteria.IsNull() && !m.ExclusionCriteria.IsUnknown() {
-		var exclusionCriteria map[string]interface{}
-		if err := json.Unmarshal([]byte(m.ExclusionCriteria.ValueString()), &exclusionCriteria); err != nil {
-			diags.AddError("Error Parsing Exclusion Criteria", err.Error())
-			return req
-		}
-		req.ExclusionCriteria = exclusionCriteria
+	// Convert match_criteria and exclusion_criteria
+	req.MatchCriteria = m.toCriteria(ctx, diags, "Match Criteria", m.MatchCriteria, m.MatchCriteriaFilter)
+	req.ExclusionCriteria = m.toCriteria(ctx, diags, "Exclusion Criteria", m.ExclusionCriteria, m.ExclusionCriteriaFilter)
+	if diags.HasError() {
+		return req
 	}
 
 	// Convert actions
@@ -456,127 +459,244 @@ func (m *PolicyModel) ToUpdateRequest(ctx context.Context, diags *diag.Diagnosti
 	return req
 }
 
-// normalizeSearchValues walks a map[string]any structure (typically from
-// match_criteria or exclusion_criteria JSON) and converts any numeric SEARCH_VALUE
-// fields to their string representation. This is needed because the API may return
-// numeric values (e.g., CVSS scores) as numbers instead of strings, causing
-// Terraform to detect an inconsistent result after apply.
-func normalizeSearchValues(data map[string]any) {
-	for key, value := range data {
-		switch v := value.(type) {
-		case map[string]any:
-			// Recurse into nested maps
-			normalizeSearchValues(v)
-		case []any:
-			// Walk array elements (e.g., the AND/OR arrays)
-			for _, elem := range v {
-				if m, ok := elem.(map[string]any); ok {
-					normalizeSearchValues(m)
-				}
-			}
-		default:
-			// Convert numeric SEARCH_VALUE to string
-			if key == "SEARCH_VALUE" {
-				switch n := value.(type) {
-				case float64:
-					// Use %v to get a clean representation (7 instead of 7.000000)
-					data[key] = fmt.Sprintf("%v", n)
-				case json.Number:
-					data[key] = n.String()
-				}
-			}
-		}
+// ValidateConfig validates the typed match and exclusion criteria of the
+// policy, including the configured field names and operators.
+func (m *PolicyModel) ValidateConfig(ctx context.Context, diags *diag.Diagnostics) {
+	validateCriteriaFilter(ctx, diags, path.Root("match_criteria_filter"), m.MatchCriteriaFilter)
+	validateCriteriaFilter(ctx, diags, path.Root("exclusion_criteria_filter"), m.ExclusionCriteriaFilter)
+}
+
+// validateCriteriaFilter validates the typed criteria filter configured at
+// attrPath.
+func validateCriteriaFilter(ctx context.Context, diags *diag.Diagnostics, attrPath path.Path, value types.Object) {
+	if value.IsNull() || value.IsUnknown() {
+		return
 	}
+
+	// Filters containing unknown lists cannot be converted, and are
+	// validated once their values are known.
+	var filter sharedModels.RootFilterModel
+	if convDiags := value.As(ctx, &filter, basetypes.ObjectAsOptions{}); convDiags.HasError() {
+		tflog.Debug(ctx, "Skipping validation of criteria filter containing unknown values", map[string]any{"path": attrPath.String()})
+		return
+	}
+
+	filter.Validate(attrPath, diags, validateCriteriaSearch)
 }
 
-// matchCriteriaJSONEqual compares two match_criteria JSON strings for semantic
-// equality, treating numeric SEARCH_VALUE fields as equivalent if they represent
-// the same numeric value (e.g., "7.0" == "7" == 7).
-// This handles the case where the user specifies "7.0" but the API returns 7.
-func matchCriteriaJSONEqual(planJSON, remoteJSON string) bool {
-	var planData, remoteData any
+// validateCriteriaSearch validates the field name and operator of a search
+// condition in the match or exclusion criteria against
+// PolicyCriteriaSearchFields, suggesting the closest supported value for
+// each unsupported one.
+func validateCriteriaSearch(attrPath path.Path, filter sharedModels.NestedFilterModel, diags *diag.Diagnostics) {
+	if filter.SearchField.IsUnknown() {
+		return
+	}
 
-	if err := json.Unmarshal([]byte(planJSON), &planData); err != nil {
-		return false
+	searchField := filter.SearchField.ValueString()
+	searchTypes, ok := PolicyCriteriaSearchFields[searchField]
+	if !ok {
+		supportedFields := slices.Sorted(maps.Keys(PolicyCriteriaSearchFields))
+		diags.AddAttributeError(
+			attrPath.AtName("search_field"),
+			"Unsupported Search Field",
+			fmt.Sprintf("Search field %s is not supported by vulnerability policy criteria.%s Supported search fields are: \"%s\".", strconv.Quote(searchField), didYouMean(searchField, supportedFields), strings.Join(supportedFields, `", "`)),
+		)
+		return
 	}
-	if err := json.Unmarshal([]byte(remoteJSON), &remoteData); err != nil {
-		return false
+
+	if filter.SearchType.IsUnknown() {
+		return
 	}
 
-	return jsonDeepEqualNumericAware(planData, remoteData)
+	searchType := filter.SearchType.ValueString()
+	if !slices.Contains(searchTypes, searchType) {
+		diags.AddAttributeError(
+			attrPath.AtName("search_type"),
+			"Unsupported Search Type",
+			fmt.Sprintf("Search type %s is not supported by search field %s.%s Supported search types are: \"%s\".", strconv.Quote(searchType), strconv.Quote(searchField), didYouMean(searchType, searchTypes), strings.Join(searchTypes, `", "`)),
+		)
+	}
 }
 
-// jsonDeepEqualNumericAware recursively compares two JSON-decoded values,
-// treating string and numeric representations of the same number as equal
-// when they appear as SEARCH_VALUE fields.
-func jsonDeepEqualNumericAware(a, b any) bool {
-	switch aVal := a.(type) {
-	case map[string]any:
-		bVal, ok := b.(map[string]any)
-		if !ok || len(aVal) != len(bVal) {
-			return false
-		}
-		for key, aChild := range aVal {
-			bChild, exists := bVal[key]
-			if !exists {
-				return false
-			}
-			if key == "SEARCH_VALUE" {
-				if !numericStringEqual(aChild, bChild) {
-					return false
-				}
-			} else {
-				if !jsonDeepEqualNumericAware(aChild, bChild) {
-					return false
-				}
-			}
+// didYouMean returns a sentence suggesting the candidate closest to value,
+// or an empty string if none of the candidates are similar.
+func didYouMean(value string, candidates []string) string {
+	if match, ok := util.ClosestMatch(value, candidates); ok {
+		return fmt.Sprintf(" Did you mean %s?", strconv.Quote(match))
+	}
+
+	return ""
+}
+
+// toCriteria returns the criteria sent to the API, which is configured either
+// as a JSON-encoded string or as a typed filter.
+func (m *PolicyModel) toCriteria(ctx context.Context, diags *diag.Diagnostics, name string, criteriaJSON util.NormalizedJSON, criteriaFilter types.Object) map[string]interface{} {
+	if !criteriaJSON.IsNull() && !criteriaJSON.IsUnknown() {
+		var criteria map[string]interface{}
+		if err := json.Unmarshal([]byte(criteriaJSON.ValueString()), &criteria); err != nil {
+			diags.AddError("Error Parsing "+name, err.Error())
+			return nil
 		}
-		return true
-	case []any:
-		bVal, ok := b.([]any)
-		if !ok || len(aVal) != len(bVal) {
-			return false
+		return criteria
+	}
+
+	if !criteriaFilter.IsNull() && !criteriaFilter.IsUnknown() {
+		var filter sharedModels.RootFilterModel
+		diags.Append(criteriaFilter.As(ctx, &filter, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil
 		}
-		for i := range aVal {
-			if !jsonDeepEqualNumericAware(aVal[i], bVal[i]) {
-				return false
-			}
+		return criteriaFromFilter(&filter)
+	}
+
+	return nil
+}
+
+// criteriaFromFilter converts a typed filter to the criteria format used by
+// the API.
+func criteriaFromFilter(filter *sharedModels.RootFilterModel) map[string]interface{} {
+	criteria := map[string]interface{}{}
+	if len(filter.And) > 0 {
+		criteria["AND"] = criteriaFromNestedFilters(filter.And)
+	}
+	if len(filter.Or) > 0 {
+		criteria["OR"] = criteriaFromNestedFilters(filter.Or)
+	}
+
+	return criteria
+}
+
+// criteriaFromNestedFilters converts a list of nested typed filters to the
+// criteria format used by the API.
+func criteriaFromNestedFilters(filters []sharedModels.NestedFilterModel) []interface{} {
+	criteria := make([]interface{}, 0, len(filters))
+	for _, filter := range filters {
+		switch {
+		case len(filter.And) > 0:
+			criteria = append(criteria, map[string]interface{}{
+				"AND": criteriaFromNestedFilters(filter.And),
+			})
+		case len(filter.Or) > 0:
+			criteria = append(criteria, map[string]interface{}{
+				"OR": criteriaFromNestedFilters(filter.Or),
+			})
+		default:
+			criteria = append(criteria, map[string]interface{}{
+				"SEARCH_FIELD": filter.SearchField.ValueString(),
+				"SEARCH_TYPE":  filter.SearchType.ValueString(),
+				"SEARCH_VALUE": filter.SearchValue.ValueString(),
+			})
 		}
-		return true
-	default:
-		return fmt.Sprintf("%v", a) == fmt.Sprintf("%v", b)
 	}
+
+	return criteria
 }
 
-// numericStringEqual compares two values that may be strings or numbers,
-// returning true if they represent the same numeric value.
-// For example: "7.0" (string) == "7" (string) == 7 (float64).
-// If neither value is numeric, falls back to string comparison.
-func numericStringEqual(a, b any) bool {
-	aNum, aIsNum := toFloat64(a)
-	bNum, bIsNum := toFloat64(b)
+// filterFromCriteria converts criteria returned by the API to a typed filter.
+// An error is returned if the criteria cannot be represented as a typed
+// filter, e.g. because a search value is neither a string nor a number.
+func filterFromCriteria(criteria map[string]any) (*sharedModels.RootFilterModel, error) {
+	data, err := json.Marshal(searchValuesToStrings(criteria))
+	if err != nil {
+		return nil, err
+	}
 
-	if aIsNum && bIsNum {
-		return aNum == bNum
+	var filter sharedModels.RootFilterModel
+	if err := json.Unmarshal(data, &filter); err != nil {
+		return nil, err
 	}
+	filter.And = compactFilters(filter.And)
+	filter.Or = compactFilters(filter.Or)
 
-	// Fall back to string comparison
-	return fmt.Sprintf("%v", a) == fmt.Sprintf("%v", b)
+	return &filter, nil
 }
 
-// toFloat64 attempts to convert a value to float64.
-// Handles float64, json.Number, and string representations of numbers.
-func toFloat64(v any) (float64, bool) {
-	switch n := v.(type) {
-	case float64:
-		return n, true
-	case json.Number:
-		f, err := n.Float64()
-		return f, err == nil
-	case string:
-		f, err := strconv.ParseFloat(n, 64)
-		return f, err == nil
+// compactFilters replaces empty lists of nested filters with nil, so that
+// they are stored as null values.
+func compactFilters(filters []sharedModels.NestedFilterModel) []sharedModels.NestedFilterModel {
+	if len(filters) == 0 {
+		return nil
+	}
+	for i := range filters {
+		filters[i].And = compactFilters(filters[i].And)
+		filters[i].Or = compactFilters(filters[i].Or)
+	}
+
+	return filters
+}
+
+// criteriaFiltersEqual compares two typed filters using the semantic
+// equality of NormalizedJSON values, so numeric search values are equivalent
+// if they represent the same number.
+func criteriaFiltersEqual(a, b *sharedModels.RootFilterModel) bool {
+	aJSON, err := json.Marshal(criteriaFromFilter(a))
+	if err != nil {
+		return false
+	}
+	bJSON, err := json.Marshal(criteriaFromFilter(b))
+	if err != nil {
+		return false
+	}
+
+	return util.JSONStringsEqual(string(aJSON), string(bJSON))
+}
+
+// refreshCriteriaFilter returns the typed filter representing the criteria
+// returned by the API. The current value is returned instead if it is
+// semantically equivalent to the API response.
+func refreshCriteriaFilter(ctx context.Context, current types.Object, remote map[string]any) types.Object {
+	if remote == nil {
+		return types.ObjectNull(sharedModels.RootFilterAttrTypeMap)
+	}
+
+	remoteFilter, err := filterFromCriteria(remote)
+	if err != nil {
+		// Criteria that cannot be represented as a typed filter can only be
+		// managed using the JSON-encoded attributes.
+		tflog.Warn(ctx, "Unable to convert criteria to a typed filter", map[string]any{"error": err.Error()})
+		return types.ObjectNull(sharedModels.RootFilterAttrTypeMap)
+	}
+
+	if !current.IsNull() && !current.IsUnknown() {
+		var currentFilter sharedModels.RootFilterModel
+		if convDiags := current.As(ctx, &currentFilter, basetypes.ObjectAsOptions{}); !convDiags.HasError() && criteriaFiltersEqual(&currentFilter, remoteFilter) {
+			tflog.Debug(ctx, "criteria filter: preserving plan value (semantically equivalent to API response)")
+			return current
+		}
+	}
+
+	value, convDiags := types.ObjectValueFrom(ctx, sharedModels.RootFilterAttrTypeMap, remoteFilter)
+	if convDiags.HasError() {
+		tflog.Warn(ctx, "Unable to convert criteria to a typed filter", map[string]any{"error": fmt.Sprintf("%v", convDiags)})
+		return types.ObjectNull(sharedModels.RootFilterAttrTypeMap)
+	}
+
+	return value
+}
+
+// searchValuesToStrings returns a copy of the given criteria in which
+// numeric search values are replaced by their string representation, which
+// is how search values are stored in typed filters.
+func searchValuesToStrings(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		converted := make(map[string]any, len(v))
+		for key, child := range v {
+			if number, ok := child.(float64); ok && key == "SEARCH_VALUE" {
+				converted[key] = strconv.FormatFloat(number, 'f', -1, 64)
+				continue
+			}
+			converted[key] = searchValuesToStrings(child)
+		}
+		return converted
+	case []any:
+		converted := make([]any, len(v))
+		for i, child := range v {
+			converted[i] = searchValuesToStrings(child)
+		}
+		return converted
 	default:
-		return 0, false
+		return value
 	}
 }
@@ -0,0 +1,182 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	sharedModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSearchFilter(field, searchType, value string) sharedModels.NestedFilterModel {
+	return sharedModels.NestedFilterModel{
+		SearchField: types.StringValue(field),
+		SearchType:  types.StringValue(searchType),
+		SearchValue: types.StringValue(value),
+	}
+}
+
+func TestCriteriaFromFilter(t *testing.T) {
+	filter := &sharedModels.RootFilterModel{
+		And: []sharedModels.NestedFilterModel{
+			testSearchFilter("SEVERITY", "EQ", "CRITICAL"),
+			{
+				Or: []sharedModels.NestedFilterModel{
+					testSearchFilter("ASSET_TAG", "CONTAINS", "test"),
+					testSearchFilter("ASSET_TAG", "CONTAINS", "dev"),
+				},
+			},
+		},
+	}
+
+	data, err := json.Marshal(criteriaFromFilter(filter))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"AND": [
+			{"SEARCH_FIELD": "SEVERITY", "SEARCH_TYPE": "EQ", "SEARCH_VALUE": "CRITICAL"},
+			{"OR": [
+				{"SEARCH_FIELD": "ASSET_TAG", "SEARCH_TYPE": "CONTAINS", "SEARCH_VALUE": "test"},
+				{"SEARCH_FIELD": "ASSET_TAG", "SEARCH_TYPE": "CONTAINS", "SEARCH_VALUE": "dev"}
+			]}
+		]
+	}`, string(data))
+}
+
+func TestFilterFromCriteria(t *testing.T) {
+	var criteria map[string]any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"AND": [
+			{"SEARCH_FIELD": "CVSS_SCORE", "SEARCH_TYPE": "GTE", "SEARCH_VALUE": 7},
+			{"OR": [
+				{"SEARCH_FIELD": "SEVERITY", "SEARCH_TYPE": "EQ", "SEARCH_VALUE": "HIGH"}
+			]}
+		],
+		"OR": []
+	}`), &criteria))
+
+	filter, err := filterFromCriteria(criteria)
+	require.NoError(t, err)
+
+	require.Len(t, filter.And, 2)
+	assert.Nil(t, filter.Or)
+	assert.Equal(t, "CVSS_SCORE", filter.And[0].SearchField.ValueString())
+	assert.Equal(t, "7", filter.And[0].SearchValue.ValueString())
+	assert.Nil(t, filter.And[0].And)
+	assert.True(t, filter.And[1].SearchField.IsNull())
+	require.Len(t, filter.And[1].Or, 1)
+	assert.Equal(t, "HIGH", filter.And[1].Or[0].SearchValue.ValueString())
+
+	// The converted filter must be representable as a Terraform value.
+	_, diags := types.ObjectValueFrom(context.Background(), sharedModels.RootFilterAttrTypeMap, filter)
+	assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+}
+
+func TestFilterFromCriteria_UnsupportedSearchValue(t *testing.T) {
+	criteria := map[string]any{
+		"AND": []any{
+			map[string]any{"SEARCH_FIELD": "SEVERITY", "SEARCH_TYPE": "IN", "SEARCH_VALUE": []any{"HIGH", "CRITICAL"}},
+		},
+	}
+
+	_, err := filterFromCriteria(criteria)
+	assert.Error(t, err)
+}
+
+func TestCriteriaFiltersEqual(t *testing.T) {
+	a := &sharedModels.RootFilterModel{
+		And: []sharedModels.NestedFilterModel{testSearchFilter("CVSS_SCORE", "GTE", "7.0")},
+	}
+	b := &sharedModels.RootFilterModel{
+		And: []sharedModels.NestedFilterModel{testSearchFilter("CVSS_SCORE", "GTE", "7")},
+	}
+	c := &sharedModels.RootFilterModel{
+		And: []sharedModels.NestedFilterModel{testSearchFilter("CVSS_SCORE", "GTE", "8")},
+	}
+	d := &sharedModels.RootFilterModel{
+		Or: []sharedModels.NestedFilterModel{testSearchFilter("CVSS_SCORE", "GTE", "7")},
+	}
+
+	assert.True(t, criteriaFiltersEqual(a, b))
+	assert.False(t, criteriaFiltersEqual(a, c))
+	assert.False(t, criteriaFiltersEqual(b, d))
+}
+
+func TestValidateCriteriaSearch(t *testing.T) {
+	testCases := []struct {
+		name        string
+		filter      sharedModels.NestedFilterModel
+		errorCount  int
+		suggestions []string
+	}{
+		{
+			name:   "valid",
+			filter: testSearchFilter("SEVERITY", "EQ", "HIGH"),
+		},
+		{
+			name:   "valid number field",
+			filter: testSearchFilter("CVSS_SCORE", "GTE", "7"),
+		},
+		{
+			name: "unknown values",
+			filter: sharedModels.NestedFilterModel{
+				SearchField: types.StringUnknown(),
+				SearchType:  types.StringUnknown(),
+				SearchValue: types.StringValue("HIGH"),
+			},
+		},
+		{
+			name:        "lower case field",
+			filter:      testSearchFilter("severity", "EQ", "HIGH"),
+			errorCount:  1,
+			suggestions: []string{`Did you mean "SEVERITY"?`},
+		},
+		{
+			name:        "misspelled field",
+			filter:      testSearchFilter("SEVERTY", "EQ", "HIGH"),
+			errorCount:  1,
+			suggestions: []string{`Did you mean "SEVERITY"?`},
+		},
+		{
+			name:        "invalid search type",
+			filter:      testSearchFilter("SEVERITY", "EQUALS", "HIGH"),
+			errorCount:  1,
+			suggestions: []string{`Supported search types are: "EQ", "NEQ", "IN", "NIN".`},
+		},
+		{
+			name:        "search type not supported by field",
+			filter:      testSearchFilter("SEVERITY", "CONTAINS", "HIGH"),
+			errorCount:  1,
+			suggestions: []string{`Search type "CONTAINS" is not supported by search field "SEVERITY".`},
+		},
+		{
+			name:        "misspelled search type",
+			filter:      testSearchFilter("ASSET_TAG", "CONTAIN", "dev"),
+			errorCount:  1,
+			suggestions: []string{`Did you mean "CONTAINS"?`},
+		},
+		{
+			name:       "unsupported field",
+			filter:     testSearchFilter("Severity Level", "eq", "HIGH"),
+			errorCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateCriteriaSearch(path.Root("match_criteria_filter").AtName("and").AtListIndex(0), tc.filter, &diags)
+			assert.Equal(t, tc.errorCount, diags.ErrorsCount(), "unexpected diagnostics: %v", diags)
+			for i, suggestion := range tc.suggestions {
+				assert.Contains(t, diags[i].Detail(), suggestion)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StringDefaultIfAttributeEquals returns a planmodifier.String that sets an
+// unconfigured attribute to defaultValue if another attribute has a specific
+// value, and to null otherwise. It is intended for Optional and Computed
+// attributes whose default depends on the value of another attribute.
+func StringDefaultIfAttributeEquals(attributePath path.Path, attributeValue attr.Value, defaultValue string) planmodifier.String {
+	return &stringDefaultIfAttributeEquals{
+		attributePath:  attributePath,
+		attributeValue: attributeValue,
+		defaultValue:   defaultValue,
+	}
+}
+
+type stringDefaultIfAttributeEquals struct {
+	attributePath  path.Path
+	attributeValue attr.Value
+	defaultValue   string
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m *stringDefaultIfAttributeEquals) Description(ctx context.Context) string {
+	return fmt.Sprintf("Defaults to `%s` if `%s` is set to `%s`.", m.defaultValue, m.attributePath.String(), m.attributeValue.String())
+}
+
+// MarkdownDescription returns a markdown-formatted description of the plan
+// modifier.
+func (m *stringDefaultIfAttributeEquals) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Defaults to `%s` if `%s` is set to `%s`.", m.defaultValue, m.attributePath.String(), m.attributeValue.String())
+}
+
+// PlanModifyString implements the planmodifier.String interface.
+func (m *stringDefaultIfAttributeEquals) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if the attribute is configured or its planned value is
+	// already known.
+	if !req.ConfigValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Create a new instance of the concrete type of m.attributeValue
+	attributeValueFromPlan := reflect.New(reflect.TypeOf(m.attributeValue)).Interface().(attr.Value)
+
+	diags := req.Plan.GetAttribute(ctx, m.attributePath, attributeValueFromPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Leave the planned value unknown until the other attribute is known
+	if attributeValueFromPlan.IsUnknown() {
+		return
+	}
+
+	if attributeValueFromPlan.Equal(m.attributeValue) {
+		resp.PlanValue = types.StringValue(m.defaultValue)
+	} else {
+		resp.PlanValue = types.StringNull()
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	sdkErrors "github.com/PaloAltoNetworks/cortex-cloud-go/errors"
+	"github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	apiKeyTypeStandard = "standard"
+	apiKeyTypeAdvanced = "advanced"
+)
+
+// validateCredentials sends a single authenticated request to the Cortex
+// Cloud API using a platform SDK client built from the given configuration
+// and adds an error diagnostic describing the likely cause if the request
+// fails. The request uses the same transport, authentication and
+// `endpoints.platform` override as the clients used by resources and data
+// sources, but is not retried.
+func validateCredentials(ctx context.Context, config sdkClientConfig, endpoint types.String, diagnostics *diag.Diagnostics) {
+	tflog.Debug(ctx, "Validating Cortex Cloud API credentials")
+
+	apiURL := sdkClientAPIURL(config, endpoint)
+	apiKeyType := strings.ToLower(config.apiKeyType)
+	if apiKeyType == "" {
+		apiKeyType = apiKeyTypeAdvanced
+	}
+
+	err := probeCredentials(ctx, config, endpoint, apiKeyType)
+	status := probeStatusCode(err)
+
+	var connErr error
+	if err != nil && status == 0 {
+		connErr = probeConnection(ctx, config, apiURL)
+	}
+
+	switch {
+	case err == nil || credentialsAccepted(status):
+		tflog.Debug(ctx, "Cortex Cloud API credentials are valid")
+	case connErr != nil:
+		// No response was received
+		diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"Unable to Connect to the Cortex Cloud API",
+			fmt.Sprintf("Unable to send a request to the Cortex Cloud API at \"%s\": %s\n\nVerify that the API URL is reachable from this machine, that `proxy_url` is correct if requests pass through a proxy, and that the certificate of the API or proxy is trusted, configuring `ca_cert_file` or `ca_cert_pem` if it is issued by a private CA.", apiURL, connErr.Error()),
+		)
+	case status == 0 || status == http.StatusNotFound:
+		// The response was not a Cortex Cloud API response
+		diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"Invalid Cortex Cloud API URL",
+			fmt.Sprintf("The server at \"%s\" did not respond as the Cortex Cloud API: %s\n\nVerify that `api_url` is the API URL of your tenant (e.g. \"https://api-<tenant>.xdr.<region>.paloaltonetworks.com\") rather than the URL of the Cortex Cloud console.", apiURL, err.Error()),
+		)
+	case status == http.StatusUnauthorized:
+		addUnauthorizedDiagnostic(ctx, config, endpoint, apiKeyType, err, diagnostics)
+	default:
+		diagnostics.AddWarning(
+			"Unable to Validate Cortex Cloud API Credentials",
+			fmt.Sprintf("The Cortex Cloud API returned an unexpected response (HTTP %d) while validating the credentials: %s", status, err.Error()),
+		)
+	}
+}
+
+// addUnauthorizedDiagnostic adds an error diagnostic for a credential probe
+// that was rejected by the API, distinguishing between an expired API key,
+// an API key of the other type and an API key ID that does not match the
+// API key.
+func addUnauthorizedDiagnostic(ctx context.Context, config sdkClientConfig, endpoint types.String, apiKeyType string, probeErr error, diagnostics *diag.Diagnostics) {
+	if strings.Contains(strings.ToLower(probeErr.Error()), "expired") {
+		diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Expired Cortex Cloud API Key",
+			fmt.Sprintf("The Cortex Cloud API key with ID %d has expired: %s\n\nCreate a new API key in the Cortex Cloud console by navigating to `Settings > Configurations` and selecting `API Keys` under the `Integrations` section.", config.apiKeyID, probeErr.Error()),
+		)
+		return
+	}
+
+	otherKeyType := apiKeyTypeStandard
+	if apiKeyType == apiKeyTypeStandard {
+		otherKeyType = apiKeyTypeAdvanced
+	}
+
+	if err := probeCredentials(ctx, config, endpoint, otherKeyType); err == nil || credentialsAccepted(probeStatusCode(err)) {
+		diagnostics.AddAttributeError(
+			path.Root("api_key_type"),
+			"Incorrect Cortex Cloud API Key Type",
+			fmt.Sprintf("The Cortex Cloud API rejected the API key as a %s key, but accepted it as a %s key. Set `api_key_type` to \"%s\".", apiKeyType, otherKeyType, otherKeyType),
+		)
+		return
+	}
+
+	diagnostics.AddAttributeError(
+		path.Root("api_key_id"),
+		"Invalid Cortex Cloud API Key ID",
+		fmt.Sprintf("The Cortex Cloud API rejected the API key with ID %d: %s\n\nVerify that `api_key_id` is the value of the `ID` column of the row associated with your API key on the `API Keys` page of the Cortex Cloud console, and that the key has not been revoked.", config.apiKeyID, probeErr.Error()),
+	)
+}
+
+// probeCredentials lists the user groups of the tenant using a platform SDK
+// client authenticated with the given API key type. Listing user groups
+// requires authentication but has no side effects.
+func probeCredentials(ctx context.Context, config sdkClientConfig, endpoint types.String, apiKeyType string) error {
+	config.apiKeyType = apiKeyType
+	config.maxRetries = 0
+
+	client, err := platform.NewClient(sdkClientOptions(config, endpoint, platformOptionFuncs)...)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ListUserGroups(ctx)
+	return err
+}
+
+// probeConnection sends an unauthenticated request to the given API URL
+// using the transport of the SDK clients, and returns the transport error
+// if no response is received. It is used to distinguish an unreachable API
+// URL from a server that responds, but not as the Cortex Cloud API.
+func probeConnection(ctx context.Context, config sdkClientConfig, apiURL string) error {
+	roundTripper := config.transport
+	if roundTripper == nil {
+		base := http.DefaultTransport.(*http.Transport).Clone()
+		base.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: config.skipSSLVerify} //nolint:gosec
+		roundTripper = base
+	}
+
+	client := &http.Client{
+		Transport: roundTripper,
+		Timeout:   time.Duration(config.timeout) * time.Second,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+// probeStatusCode returns the HTTP status code of the response to the
+// credential probe, or 0 if no response was received.
+func probeStatusCode(err error) int {
+	var sdkErr *sdkErrors.CortexCloudSdkError
+	if sdkErrors.AsCortexCloudSdkError(err, &sdkErr) && sdkErr.HTTPStatus != nil {
+		return int(*sdkErr.HTTPStatus)
+	}
+
+	return 0
+}
+
+// credentialsAccepted returns true if the given status code shows that the
+// API accepted the credentials. Responses indicating a missing license
+// (402) or insufficient permissions (403) are only returned for
+// authenticated requests.
+func credentialsAccepted(status int) bool {
+	return status == http.StatusPaymentRequired || status == http.StatusForbidden
+}
@@ -0,0 +1,190 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAPIKey   = "test-api-key"
+	testAPIKeyID = 123
+)
+
+// newAuthServer returns a test server that authenticates requests to the
+// user group endpoint using the test API key of the given type, or rejects
+// every request as expired.
+func newAuthServer(t *testing.T, apiKeyType string, expired bool) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/user-group") || r.Method != http.MethodGet {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if expired {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprintln(w, `{"reply": {"err_code": 401, "err_msg": "Public API request unauthorized", "err_extra": "API key has expired"}}`) //nolint:errcheck
+			return
+		}
+
+		authorization := testAPIKey
+		if apiKeyType == "advanced" {
+			hash := sha256.Sum256([]byte(testAPIKey + r.Header.Get("x-xdr-nonce") + r.Header.Get("x-xdr-timestamp")))
+			authorization = hex.EncodeToString(hash[:])
+		}
+
+		if r.Header.Get("x-xdr-auth-id") != fmt.Sprint(testAPIKeyID) || r.Header.Get("Authorization") != authorization {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprintln(w, `{"reply": {"err_code": 401, "err_msg": "Public API request unauthorized", "err_extra": null}}`) //nolint:errcheck
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, `{"data": []}`) //nolint:errcheck
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestUnitValidateCredentials(t *testing.T) {
+	advancedServer := newAuthServer(t, "advanced", false)
+	standardServer := newAuthServer(t, "standard", false)
+	expiredServer := newAuthServer(t, "advanced", true)
+
+	htmlServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprintln(w, `<html><body>Cortex Cloud</body></html>`) //nolint:errcheck
+	}))
+	t.Cleanup(htmlServer.Close)
+
+	notFoundServer := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(notFoundServer.Close)
+
+	closedServer := httptest.NewServer(http.NotFoundHandler())
+	closedServer.Close()
+
+	tests := []struct {
+		name            string
+		apiURL          string
+		platformURL     string
+		apiKeyID        int32
+		apiKeyType      string
+		expectedSummary string
+		expectedDetail  string
+		expectedPath    path.Path
+	}{
+		{
+			name:     "valid advanced API key",
+			apiURL:   advancedServer.URL,
+			apiKeyID: testAPIKeyID,
+		},
+		{
+			name:       "valid standard API key",
+			apiURL:     standardServer.URL + "/",
+			apiKeyID:   testAPIKeyID,
+			apiKeyType: "standard",
+		},
+		{
+			name:        "platform endpoint override",
+			apiURL:      closedServer.URL,
+			platformURL: advancedServer.URL,
+			apiKeyID:    testAPIKeyID,
+		},
+		{
+			name:            "wrong API key type",
+			apiURL:          standardServer.URL,
+			apiKeyID:        testAPIKeyID,
+			apiKeyType:      "advanced",
+			expectedSummary: "Incorrect Cortex Cloud API Key Type",
+			expectedPath:    path.Root("api_key_type"),
+		},
+		{
+			name:            "wrong API key ID",
+			apiURL:          advancedServer.URL,
+			apiKeyID:        456,
+			expectedSummary: "Invalid Cortex Cloud API Key ID",
+			expectedPath:    path.Root("api_key_id"),
+		},
+		{
+			name:            "expired API key",
+			apiURL:          expiredServer.URL,
+			apiKeyID:        testAPIKeyID,
+			expectedSummary: "Expired Cortex Cloud API Key",
+			expectedPath:    path.Root("api_key"),
+		},
+		{
+			name:            "API URL of another server",
+			apiURL:          notFoundServer.URL,
+			apiKeyID:        testAPIKeyID,
+			expectedSummary: "Invalid Cortex Cloud API URL",
+			expectedPath:    path.Root("api_url"),
+		},
+		{
+			name:            "API URL of a web page",
+			apiURL:          htmlServer.URL,
+			apiKeyID:        testAPIKeyID,
+			expectedSummary: "Invalid Cortex Cloud API URL",
+			expectedPath:    path.Root("api_url"),
+		},
+		{
+			name:            "unreachable API URL",
+			apiURL:          closedServer.URL,
+			apiKeyID:        testAPIKeyID,
+			expectedSummary: "Unable to Connect to the Cortex Cloud API",
+			expectedDetail:  "connection refused",
+			expectedPath:    path.Root("api_url"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := sdkClientConfig{
+				apiURL:     tt.apiURL,
+				apiKey:     testAPIKey,
+				apiKeyID:   int(tt.apiKeyID),
+				apiKeyType: tt.apiKeyType,
+				timeout:    5,
+			}
+
+			platformURL := types.StringNull()
+			if tt.platformURL != "" {
+				platformURL = types.StringValue(tt.platformURL)
+			}
+
+			var diags diag.Diagnostics
+			validateCredentials(context.Background(), config, platformURL, &diags)
+
+			if tt.expectedSummary == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags.Errors())
+				return
+			}
+
+			require.Equal(t, 1, diags.ErrorsCount(), "expected a single error, got: %v", diags.Errors())
+			assert.Equal(t, tt.expectedSummary, diags.Errors()[0].Summary())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.expectedDetail)
+
+			withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+			require.True(t, ok, "expected an attribute error")
+			assert.True(t, withPath.Path().Equal(tt.expectedPath), "expected path %s, got %s", tt.expectedPath, withPath.Path())
+		})
+	}
+}
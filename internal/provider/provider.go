@@ -7,15 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/PaloAltoNetworks/cortex-cloud-go/appsec"
-	"github.com/PaloAltoNetworks/cortex-cloud-go/cloudonboarding"
-	"github.com/PaloAltoNetworks/cortex-cloud-go/cloudsec"
-	"github.com/PaloAltoNetworks/cortex-cloud-go/compliance"
-	"github.com/PaloAltoNetworks/cortex-cloud-go/cwp"
-	"github.com/PaloAltoNetworks/cortex-cloud-go/log"
-	"github.com/PaloAltoNetworks/cortex-cloud-go/platform"
-	"github.com/PaloAltoNetworks/cortex-cloud-go/vulnerability"
-
 	appsecDataSources "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/data_sources/appsec"
 	cloudOnboardingDataSources "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/data_sources/cloud_onboarding"
 	cloudsecDataSources "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/data_sources/cloudsec"
@@ -32,6 +23,8 @@ import (
 	cwpResources "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/resources/cwp"
 	platformResources "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/resources/platform"
 	vulnerabilityResources "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/resources/vulnerability"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/transport"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -40,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -119,8 +113,52 @@ func (p *CortexCloudProvider) Schema(ctx context.Context, req provider.SchemaReq
 					models.APIKeyTypeEnvVar),
 			},
 			"config_file": schema.StringAttribute{
+				Optional: true,
+				Description: "The path to a JSON or YAML file containing the provider configuration values. " +
+					"\n\n\tThe file may define named profiles under the `profiles` key, which can be selected using the `profile` attribute.\n",
+			},
+			"profile": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("The name of the profile in the config file to read the provider configuration values from. Values defined in the selected profile take precedence over the values defined at the top level of the config file. "+
+					"\n\n\tIf `config_file` is not configured, the profile will be read from `~/.cortexcloud/config`. "+
+					"\n\n\tCan also be configured using the `%s` environment variable.\n",
+					models.ProfileEnvVar),
+			},
+			"credential_process": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("A command that outputs the Cortex Cloud API credentials as JSON to stdout. The command is run using the system shell (`sh` on Unix systems, `cmd.exe` on Windows) and its output takes precedence over the `api_url`, `api_key`, `api_key_id` and `api_key_type` values configured by any other method. "+
+					"\n\n\tThe output must contain the `api_key` value and may contain the `api_url`, `api_key_id`, `api_key_type` and `expiration` values. The `expiration` value is an RFC 3339 timestamp after which the credentials are no longer valid. The output is cached in memory until its expiration, or for the lifetime of the provider process if no expiration is returned. The expiration is only checked when the provider is configured. "+
+					"\n\n\tCan also be configured using the `%s` environment variable.\n",
+					models.CredentialProcessEnvVar),
+			},
+			"validate_credentials": schema.BoolAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Sends a single authenticated request to the Cortex Cloud API when the provider is configured to verify the `api_url`, `api_key`, `api_key_id` and `api_key_type` values. "+
+					"\n\n\tIf the request fails, an error describing the likely cause (an incorrect API URL, API key type or API key ID, or an expired API key) is returned before any resource or data source is read, rather than after the requests to the API have been retried. "+
+					"\n\n\tDefaults to `false`. "+
+					"\n\n\tCan also be configured using the `%s` environment variable.\n",
+					models.ValidateCredentialsEnvVar),
+			},
+			"default_labels": schema.SetAttribute{
 				Optional:    true,
-				Description: "The path to a JSON file containing the provider configuration values.\n",
+				ElementType: types.StringType,
+				Description: "Labels to assign to every resource that supports labels (`cortexcloud_appsec_rule`, `cortexcloud_cloudsec_policy`, `cortexcloud_cloudsec_rule` and `cortexcloud_compliance_standard`), e.g. `managed-by:terraform`. " +
+					"\n\n\tThe default labels are merged with the labels configured on each resource at plan time and are reported in the resource's `effective_labels` attribute. Default labels are not reported as drift in the resource's `labels` attribute unless they are also configured there.\n",
+			},
+			"detect_external_changes": schema.BoolAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Emits a warning when a resource is refreshed and the API reports that it was last modified by an identity other than the API key used by the provider, e.g. by a user in the Cortex Cloud console. Each change is only reported by the first refresh that observes it. "+
+					"\n\n\tApplies to the resources that report who last modified them: `cortexcloud_appsec_policy`, `cortexcloud_asset_group`, `cortexcloud_cloudsec_policy`, `cortexcloud_cloudsec_rule`, `cortexcloud_compliance_assessment_profile`, `cortexcloud_compliance_control` and `cortexcloud_vulnerability_policy`. "+
+					"\n\n\tDefaults to `false`. "+
+					"\n\n\tCan also be configured using the `%s` environment variable.\n",
+					models.DetectExternalChangesEnvVar),
+			},
+			"api_key_identity": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("The name that the Cortex Cloud API records as the modifier of objects changed using the configured API key. Used by `detect_external_changes` to identify changes made outside of Terraform. "+
+					"\n\n\tRequired when `detect_external_changes` is `true`. "+
+					"\n\n\tCan also be configured using the `%s` environment variable.\n",
+					models.APIKeyIdentityEnvVar),
 			},
 			"sdk_log_level": schema.StringAttribute{
 				Optional: true,
@@ -133,6 +171,21 @@ func (p *CortexCloudProvider) Schema(ctx context.Context, req provider.SchemaReq
 					"\n\n>[!WARNING]\n>Setting this value to `debug` will cause the SDK to log all API requests and responses, which include sensitive values such as your API key.\n",
 					models.SDKLogLevelEnvVar),
 			},
+			"http_trace": schema.BoolAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Writes a JSON record of every HTTP request sent to the Cortex Cloud API and its response to the `%s` log subsystem, independently of `sdk_log_level`. "+
+					"\n\n\tEach record contains the method, path, status code and latency of the request, the request and response headers and bodies, and a `correlation_id` shared by the requests sent during the same resource, data source or ephemeral resource operation. The values of authentication headers and of sensitive attributes such as API keys, passwords, secrets and private keys are replaced with `REDACTED`. "+
+					"\n\n\tDefaults to `false`. "+
+					"\n\n\tCan also be configured using the `%s` environment variable."+
+					"\n\n>[!NOTE]\n>The records will only be visible in the provider logs if the [provider log level](https://developer.hashicorp.com/terraform/internals/debugging) is set to `DEBUG` or `TRACE`, e.g. using the `TF_LOG_PROVIDER` environment variable.\n",
+					transport.TraceSubsystem, models.HTTPTraceEnvVar),
+			},
+			"http_trace_file": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("The path of a file to which the HTTP trace records described in `http_trace` are appended, one JSON object per line, instead of the provider logs. Setting this value enables tracing regardless of the value of `http_trace`. "+
+					"\n\n\tCan also be configured using the `%s` environment variable.\n",
+					models.HTTPTraceFileEnvVar),
+			},
 			"skip_ssl_verify": schema.BoolAttribute{
 				Optional: true,
 				Description: fmt.Sprintf("Toggles SSL certificate verification for requests against the Cortex Cloud API."+
@@ -140,6 +193,39 @@ func (p *CortexCloudProvider) Schema(ctx context.Context, req provider.SchemaReq
 					"\n\n\tCan also be configured using the `%s` environment variable.\n",
 					models.SkipSSLVerifyEnvVar),
 			},
+			"proxy_url": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("The URL of the proxy server used for requests to the Cortex Cloud API. Supported schemes are `http`, `https` and `socks5`. "+
+					"\n\n\tIf not configured, the proxy is read from the `HTTPS_PROXY` and `NO_PROXY` environment variables. "+
+					"\n\n\tCan also be configured using the `%s` environment variable.\n",
+					models.ProxyURLEnvVar),
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("The path to a file containing one or more PEM-encoded CA certificates to trust, in addition to the system's root CA certificates, when verifying the certificate of the Cortex Cloud API or proxy server. "+
+					"\n\n\tUseful when requests pass through a TLS-inspecting proxy that presents certificates issued by a private CA. "+
+					"\n\n\tCan also be configured using the `%s` environment variable.\n",
+					models.CACertFileEnvVar),
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("One or more PEM-encoded CA certificates to trust, in addition to the system's root CA certificates, when verifying the certificate of the Cortex Cloud API or proxy server. May be combined with `ca_cert_file`. "+
+					"\n\n\tCan also be configured using the `%s` environment variable.\n",
+					models.CACertPEMEnvVar),
+			},
+			"client_cert": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("The PEM-encoded client certificate, or the path to a file containing it, presented for mutual TLS authentication. Must be configured together with `client_key`. "+
+					"\n\n\tCan also be configured using the `%s` environment variable.\n",
+					models.ClientCertEnvVar),
+			},
+			"client_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: fmt.Sprintf("The PEM-encoded private key of the client certificate, or the path to a file containing it. Must be configured together with `client_cert`. "+
+					"\n\n\tCan also be configured using the `%s` environment variable.\n",
+					models.ClientKeyEnvVar),
+			},
 			"request_timeout": schema.Int32Attribute{
 				Optional: true,
 				Description: fmt.Sprintf("The amount of time (in seconds) the provider will wait for a response to for a given request to the Cortex Cloud API before timing out. "+
@@ -162,6 +248,21 @@ func (p *CortexCloudProvider) Schema(ctx context.Context, req provider.SchemaReq
 					"\n\n\tCan also be configured using the `%s` environment variable.\n",
 					models.RequestMaxRetryDelayEnvVar),
 			},
+			"max_requests_per_second": schema.Int32Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("The maximum number of requests per second the provider will send to the Cortex Cloud API. The limit is shared by all resources and data sources, and applies to retried requests as well. "+
+					"\n\n\tSetting this value helps avoid rate limiting (HTTP 429) responses when running Terraform with a high `-parallelism` value. "+
+					"\n\n\tDefaults to `0` (unlimited). "+
+					"\n\n\tCan also be configured using the `%s` environment variable.\n",
+					models.MaxRequestsPerSecondEnvVar),
+			},
+			"max_concurrent_requests": schema.Int32Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("The maximum number of requests to the Cortex Cloud API the provider will have in flight at once. The limit is shared by all resources and data sources. "+
+					"\n\n\tDefaults to `0` (unlimited). "+
+					"\n\n\tCan also be configured using the `%s` environment variable.\n",
+					models.MaxConcurrentRequestsEnvVar),
+			},
 			"crash_stack_dir": schema.StringAttribute{
 				Optional: true,
 				Description: fmt.Sprintf("The directory where text files containing the stack dump (also known as a stack trace) will be written whenever the provider encounters a runtime error or panics."+
@@ -172,6 +273,42 @@ func (p *CortexCloudProvider) Schema(ctx context.Context, req provider.SchemaReq
 					models.CrashStackDirEnvVar),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"endpoints": schema.SingleNestedBlock{
+				Description: "Overrides the base URL used for the requests to each Cortex Cloud API domain. " +
+					"\n\n\tUseful for routing individual API domains through a different gateway (e.g. a staging environment) or pointing them at a local mock server during testing. Domains without an override use the value of `api_url`.\n",
+				Attributes: map[string]schema.Attribute{
+					"appsec": schema.StringAttribute{
+						Optional:    true,
+						Description: "The base URL for requests to the AppSec APIs. Defaults to the value of `api_url`.\n",
+					},
+					"cloudonboarding": schema.StringAttribute{
+						Optional:    true,
+						Description: "The base URL for requests to the Cloud Onboarding APIs. Defaults to the value of `api_url`.\n",
+					},
+					"cloudsec": schema.StringAttribute{
+						Optional:    true,
+						Description: "The base URL for requests to the CloudSec APIs. Defaults to the value of `api_url`.\n",
+					},
+					"compliance": schema.StringAttribute{
+						Optional:    true,
+						Description: "The base URL for requests to the Compliance APIs. Defaults to the value of `api_url`.\n",
+					},
+					"cwp": schema.StringAttribute{
+						Optional:    true,
+						Description: "The base URL for requests to the Cloud Workload Protection (CWP) APIs. Defaults to the value of `api_url`.\n",
+					},
+					"platform": schema.StringAttribute{
+						Optional:    true,
+						Description: "The base URL for requests to the Platform APIs. Defaults to the value of `api_url`.\n",
+					},
+					"vulnerability": schema.StringAttribute{
+						Optional:    true,
+						Description: "The base URL for requests to the Vulnerability Management APIs. Defaults to the value of `api_url`.\n",
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -209,6 +346,7 @@ func (p *CortexCloudProvider) Resources(ctx context.Context) []func() resource.R
 		platformResources.NewNotificationForwardingConfigManagementAuditLogsResource,
 		platformResources.NewNotificationForwardingConfigIssuesResource,
 		platformResources.NewNotificationForwardingConfigCasesResource,
+		platformResources.NewNotificationForwardingConfigResource,
 	)
 
 	tflog.Debug(ctx, "Registering Compliance resources")
@@ -269,6 +407,13 @@ func (p *CortexCloudProvider) DataSources(ctx context.Context) []func() datasour
 		platformDataSources.NewIamRoleDataSource,
 		platformDataSources.NewGroupDataSource,
 		platformDataSources.NewIamPermissionConfigDataSource,
+		platformDataSources.NewAssetGroupDataSource,
+		platformDataSources.NewAssetGroupsDataSource,
+		platformDataSources.NewUsersDataSource,
+		platformDataSources.NewUserGroupsDataSource,
+		platformDataSources.NewScopeDataSource,
+		platformDataSources.NewScopesDataSource,
+		platformDataSources.NewNotificationForwardingConfigDataSource,
 	)
 
 	tflog.Debug(ctx, "Registering Compliance data sources")
@@ -342,6 +487,12 @@ func getProviderConfig(ctx context.Context, config tfsdk.Config, diagnostics *di
 		}
 	}
 
+	// Run credential process, if configured
+	providerConfig.ParseCredentialProcess(ctx, diagnostics)
+	if diagnostics.HasError() {
+		return &models.CortexCloudProviderModel{}
+	}
+
 	// Validate provider configuration
 	providerConfig.Validate(ctx, diagnostics)
 	if diagnostics.HasError() {
@@ -360,156 +511,50 @@ func (p *CortexCloudProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	// Initialize SDK clients
-	clients := models.CortexCloudSDKClients{}
-
-	tflog.Debug(ctx, "Initializing platform client")
-	platformClient, err := platform.NewClient(
-		platform.WithCortexAPIURL(providerConfig.APIURL.ValueString()),
-		platform.WithCortexAPIKey(providerConfig.APIKey.ValueString()),
-		platform.WithCortexAPIKeyID(int(providerConfig.APIKeyID.ValueInt32())),
-		platform.WithCortexAPIKeyType(providerConfig.APIKeyType.ValueString()),
-		platform.WithSkipSSLVerify(providerConfig.SkipSSLVerify.ValueBool()),
-		platform.WithTimeout(int(providerConfig.RequestTimeout.ValueInt32())),
-		platform.WithMaxRetries(int(providerConfig.RequestMaxRetries.ValueInt32())),
-		platform.WithRetryMaxDelay(int(providerConfig.RequestMaxRetryDelay.ValueInt32())),
-		platform.WithCrashStackDir(providerConfig.CrashStackDir.ValueString()),
-		platform.WithLogger(log.TflogAdapter{}),
-		platform.WithLogLevel(providerConfig.SDKLogLevel.ValueString()),
-	)
+	// Build the configuration shared by the SDK clients of every API domain,
+	// including the HTTP transport, once for the provider as a whole
+	sdkConfig, err := newSDKClientConfig(providerConfig)
 	if err != nil {
-		resp.Diagnostics.AddError("Cortex Cloud API Setup Error", err.Error())
+		resp.Diagnostics.AddError(
+			"Provider Configuration Error",
+			fmt.Sprintf("Error occured configuring the SDK clients: %s", err.Error()),
+		)
 		return
 	}
 
-	tflog.Debug(ctx, "Initializing cloudonboarding client")
-	cloudOnboardingClient, err := cloudonboarding.NewClient(
-		platform.WithCortexAPIURL(providerConfig.APIURL.ValueString()),
-		platform.WithCortexAPIKey(providerConfig.APIKey.ValueString()),
-		platform.WithCortexAPIKeyID(int(providerConfig.APIKeyID.ValueInt32())),
-		platform.WithCortexAPIKeyType(providerConfig.APIKeyType.ValueString()),
-		platform.WithSkipSSLVerify(providerConfig.SkipSSLVerify.ValueBool()),
-		platform.WithTimeout(int(providerConfig.RequestTimeout.ValueInt32())),
-		platform.WithMaxRetries(int(providerConfig.RequestMaxRetries.ValueInt32())),
-		platform.WithRetryMaxDelay(int(providerConfig.RequestMaxRetryDelay.ValueInt32())),
-		platform.WithCrashStackDir(providerConfig.CrashStackDir.ValueString()),
-		platform.WithLogger(log.TflogAdapter{}),
-		platform.WithLogLevel(providerConfig.SDKLogLevel.ValueString()),
-	)
-	if err != nil {
-		resp.Diagnostics.AddError("Cortex Cloud API Setup Error", err.Error())
-		return
+	endpoints := providerConfig.Endpoints
+	if endpoints == nil {
+		endpoints = &models.EndpointsModel{}
 	}
 
-	tflog.Debug(ctx, "Initializing cloudsec client")
-	cloudSecClient, err := cloudsec.NewClient(
-		platform.WithCortexAPIURL(providerConfig.APIURL.ValueString()),
-		platform.WithCortexAPIKey(providerConfig.APIKey.ValueString()),
-		platform.WithCortexAPIKeyID(int(providerConfig.APIKeyID.ValueInt32())),
-		platform.WithCortexAPIKeyType(providerConfig.APIKeyType.ValueString()),
-		platform.WithSkipSSLVerify(providerConfig.SkipSSLVerify.ValueBool()),
-		platform.WithTimeout(int(providerConfig.RequestTimeout.ValueInt32())),
-		platform.WithMaxRetries(int(providerConfig.RequestMaxRetries.ValueInt32())),
-		platform.WithRetryMaxDelay(int(providerConfig.RequestMaxRetryDelay.ValueInt32())),
-		platform.WithCrashStackDir(providerConfig.CrashStackDir.ValueString()),
-		platform.WithLogger(log.TflogAdapter{}),
-		platform.WithLogLevel(providerConfig.SDKLogLevel.ValueString()),
-	)
-	if err != nil {
-		resp.Diagnostics.AddError("Cortex Cloud API Setup Error", err.Error())
-		return
+	// Verify the credentials before any request is sent by a resource or
+	// data source, if enabled
+	if providerConfig.ValidateCredentials.ValueBool() {
+		validateCredentials(ctx, sdkConfig, endpoints.Platform, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	tflog.Debug(ctx, "Initializing appsec client")
-	appsecClient, err := appsec.NewClient(
-		appsec.WithCortexAPIURL(providerConfig.APIURL.ValueString()),
-		appsec.WithCortexAPIKey(providerConfig.APIKey.ValueString()),
-		appsec.WithCortexAPIKeyID(int(providerConfig.APIKeyID.ValueInt32())),
-		appsec.WithCortexAPIKeyType(providerConfig.APIKeyType.ValueString()),
-		appsec.WithSkipSSLVerify(providerConfig.SkipSSLVerify.ValueBool()),
-		appsec.WithTimeout(int(providerConfig.RequestTimeout.ValueInt32())),
-		appsec.WithMaxRetries(int(providerConfig.RequestMaxRetries.ValueInt32())),
-		appsec.WithRetryMaxDelay(int(providerConfig.RequestMaxRetryDelay.ValueInt32())),
-		appsec.WithCrashStackDir(providerConfig.CrashStackDir.ValueString()),
-		appsec.WithLogger(log.TflogAdapter{}),
-		appsec.WithLogLevel(providerConfig.SDKLogLevel.ValueString()),
-	)
-	if err != nil {
-		resp.Diagnostics.AddError("Cortex Cloud API Setup Error", err.Error())
-		return
-	}
+	// Initialize SDK clients. Each client is set up when it is first used by
+	// a resource or data source.
+	clients := newSDKClients(sdkConfig, endpoints)
 
-	tflog.Debug(ctx, "Initializing compliance client")
-	complianceClient, err := compliance.NewClient(
-		compliance.WithCortexAPIURL(providerConfig.APIURL.ValueString()),
-		compliance.WithCortexAPIKey(providerConfig.APIKey.ValueString()),
-		compliance.WithCortexAPIKeyID(int(providerConfig.APIKeyID.ValueInt32())),
-		compliance.WithCortexAPIKeyType(providerConfig.APIKeyType.ValueString()),
-		compliance.WithSkipSSLVerify(providerConfig.SkipSSLVerify.ValueBool()),
-		compliance.WithTimeout(int(providerConfig.RequestTimeout.ValueInt32())),
-		compliance.WithMaxRetries(int(providerConfig.RequestMaxRetries.ValueInt32())),
-		compliance.WithRetryMaxDelay(int(providerConfig.RequestMaxRetryDelay.ValueInt32())),
-		compliance.WithCrashStackDir(providerConfig.CrashStackDir.ValueString()),
-		compliance.WithLogger(log.TflogAdapter{}),
-		compliance.WithLogLevel(providerConfig.SDKLogLevel.ValueString()),
-	)
-	if err != nil {
-		resp.Diagnostics.AddError("Cortex Cloud API Setup Error", err.Error())
-		return
+	// Make the default labels available to the resources that support them
+	if !providerConfig.DefaultLabels.IsNull() && !providerConfig.DefaultLabels.IsUnknown() {
+		resp.Diagnostics.Append(providerConfig.DefaultLabels.ElementsAs(ctx, &clients.DefaultLabels, false)...)
 	}
 
-	tflog.Debug(ctx, "Initializing vulnerability client")
-	vulnerabilityClient, err := vulnerability.NewClient(
-		vulnerability.WithCortexAPIURL(providerConfig.APIURL.ValueString()),
-		vulnerability.WithCortexAPIKey(providerConfig.APIKey.ValueString()),
-		vulnerability.WithCortexAPIKeyID(int(providerConfig.APIKeyID.ValueInt32())),
-		vulnerability.WithCortexAPIKeyType(providerConfig.APIKeyType.ValueString()),
-		vulnerability.WithSkipSSLVerify(providerConfig.SkipSSLVerify.ValueBool()),
-		vulnerability.WithTimeout(int(providerConfig.RequestTimeout.ValueInt32())),
-		vulnerability.WithMaxRetries(int(providerConfig.RequestMaxRetries.ValueInt32())),
-		vulnerability.WithRetryMaxDelay(int(providerConfig.RequestMaxRetryDelay.ValueInt32())),
-		vulnerability.WithCrashStackDir(providerConfig.CrashStackDir.ValueString()),
-		vulnerability.WithLogger(log.TflogAdapter{}),
-		vulnerability.WithLogLevel(providerConfig.SDKLogLevel.ValueString()),
-	)
-	if err != nil {
-		resp.Diagnostics.AddError("Cortex Cloud API Setup Error", err.Error())
-		return
+	// Make the external change detection settings available to the
+	// resources that report who last modified them
+	clients.ExternalChanges = util.ExternalChangeDetection{
+		Enabled:  providerConfig.DetectExternalChanges.ValueBool(),
+		Identity: providerConfig.APIKeyIdentity.ValueString(),
 	}
 
-	tflog.Debug(ctx, "Cortex Cloud API client setup complete")
-
-	tflog.Debug(ctx, "Initializing CWP client")
-	cwpClient, err := cwp.NewClient(
-		cwp.WithCortexAPIURL(providerConfig.APIURL.ValueString()),
-		cwp.WithCortexAPIKey(providerConfig.APIKey.ValueString()),
-		cwp.WithCortexAPIKeyID(int(providerConfig.APIKeyID.ValueInt32())),
-		cwp.WithCortexAPIKeyType(providerConfig.APIKeyType.ValueString()),
-		cwp.WithSkipSSLVerify(providerConfig.SkipSSLVerify.ValueBool()),
-		cwp.WithTimeout(int(providerConfig.RequestTimeout.ValueInt32())),
-		cwp.WithMaxRetries(int(providerConfig.RequestMaxRetries.ValueInt32())),
-		cwp.WithRetryMaxDelay(int(providerConfig.RequestMaxRetryDelay.ValueInt32())),
-		cwp.WithCrashStackDir(providerConfig.CrashStackDir.ValueString()),
-		cwp.WithLogger(log.TflogAdapter{}),
-		cwp.WithLogLevel(providerConfig.SDKLogLevel.ValueString()),
-	)
-	if err != nil {
-		resp.Diagnostics.AddError("Cortex Cloud API Setup Error", err.Error())
-		return
-	}
-
-	// Attach SDK clients to model
-	clients.AppSec = appsecClient
-	clients.CloudOnboarding = cloudOnboardingClient
-	clients.CloudSec = cloudSecClient
-	clients.Compliance = complianceClient
-	clients.CWP = cwpClient
-	clients.Platform = platformClient
-	clients.Vulnerability = vulnerabilityClient
-
 	// Assign clients model pointer to ProviderData to allow resources and
 	// data sources to access SDK functions
-	resp.DataSourceData = &clients
-	resp.ResourceData = &clients
-	resp.EphemeralResourceData = &clients
+	resp.DataSourceData = clients
+	resp.ResourceData = clients
+	resp.EphemeralResourceData = clients
 }
@@ -0,0 +1,332 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+
+	"github.com/PaloAltoNetworks/cortex-cloud-go/appsec"
+	"github.com/PaloAltoNetworks/cortex-cloud-go/cloudonboarding"
+	"github.com/PaloAltoNetworks/cortex-cloud-go/cloudsec"
+	"github.com/PaloAltoNetworks/cortex-cloud-go/compliance"
+	"github.com/PaloAltoNetworks/cortex-cloud-go/cwp"
+	"github.com/PaloAltoNetworks/cortex-cloud-go/log"
+	"github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+	"github.com/PaloAltoNetworks/cortex-cloud-go/vulnerability"
+
+	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/transport"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// sdkClientConfig contains the configuration values shared by the SDK
+// clients of every API domain.
+type sdkClientConfig struct {
+	apiURL        string
+	apiKey        string
+	apiKeyID      int
+	apiKeyType    string
+	skipSSLVerify bool
+	timeout       int
+	maxRetries    int
+	retryMaxDelay int
+	crashStackDir string
+	logLevel      string
+	transport     http.RoundTripper
+}
+
+// newSDKClientConfig returns the SDK client configuration values for the
+// given provider configuration.
+func newSDKClientConfig(providerConfig *models.CortexCloudProviderModel) (sdkClientConfig, error) {
+	sdkTransport, err := newSDKTransport(providerConfig)
+	if err != nil {
+		return sdkClientConfig{}, err
+	}
+
+	return sdkClientConfig{
+		apiURL:        providerConfig.APIURL.ValueString(),
+		apiKey:        providerConfig.APIKey.ValueString(),
+		apiKeyID:      int(providerConfig.APIKeyID.ValueInt32()),
+		apiKeyType:    providerConfig.APIKeyType.ValueString(),
+		skipSSLVerify: providerConfig.SkipSSLVerify.ValueBool(),
+		timeout:       int(providerConfig.RequestTimeout.ValueInt32()),
+		maxRetries:    int(providerConfig.RequestMaxRetries.ValueInt32()),
+		retryMaxDelay: int(providerConfig.RequestMaxRetryDelay.ValueInt32()),
+		crashStackDir: providerConfig.CrashStackDir.ValueString(),
+		logLevel:      providerConfig.SDKLogLevel.ValueString(),
+		transport:     sdkTransport,
+	}, nil
+}
+
+// newSDKTransport returns the HTTP transport shared by the SDK clients of
+// every API domain, so that the proxy, TLS, request limit and HTTP trace
+// settings apply to the provider as a whole. If none of these settings are
+// configured, nil is returned and each SDK client uses its default transport.
+func newSDKTransport(providerConfig *models.CortexCloudProviderModel) (http.RoundTripper, error) {
+	proxyURL, err := providerConfig.Proxy()
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy_url: %w", err)
+	}
+
+	tlsConfig, err := providerConfig.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	requestsPerSecond := int(providerConfig.MaxRequestsPerSecond.ValueInt32())
+	maxConcurrent := int(providerConfig.MaxConcurrentRequests.ValueInt32())
+	limited := requestsPerSecond > 0 || maxConcurrent > 0
+
+	var traceSink transport.TraceSink
+	if traceFile := providerConfig.HTTPTraceFile.ValueString(); traceFile != "" {
+		traceSink, err = transport.NewFileTraceSink(traceFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid http_trace_file: %w", err)
+		}
+	} else if providerConfig.HTTPTrace.ValueBool() {
+		traceSink = transport.NewLogTraceSink()
+	}
+
+	if proxyURL == nil && tlsConfig == nil && !limited && traceSink == nil {
+		return nil, nil
+	}
+
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	if proxyURL != nil {
+		base.Proxy = http.ProxyURL(proxyURL)
+	}
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	tlsConfig.InsecureSkipVerify = providerConfig.SkipSSLVerify.ValueBool() //nolint:gosec
+	base.TLSClientConfig = tlsConfig
+
+	var sdkTransport http.RoundTripper = base
+
+	// Trace requests inside the limiter so that the recorded latency does
+	// not include the time spent waiting for the limiter
+	if traceSink != nil {
+		sdkTransport = transport.NewTraceTransport(sdkTransport, traceSink)
+	}
+
+	if limited {
+		sdkTransport = transport.NewLimitedTransport(sdkTransport, transport.NewLimiter(requestsPerSecond, maxConcurrent))
+	}
+
+	return sdkTransport, nil
+}
+
+// sdkOptionFuncs contains the functional option constructors of an SDK
+// domain package. Each domain package defines its own option type, so the
+// constructors are collected per package and applied to the shared
+// sdkClientConfig by sdkClientOptions.
+type sdkOptionFuncs[O any] struct {
+	apiURL        func(string) O
+	apiKey        func(string) O
+	apiKeyID      func(int) O
+	apiKeyType    func(string) O
+	skipSSLVerify func(bool) O
+	timeout       func(int) O
+	maxRetries    func(int) O
+	retryMaxDelay func(int) O
+	crashStackDir func(string) O
+	logger        func(log.Logger) O
+	logLevel      func(string) O
+	transport     func(http.RoundTripper) O
+}
+
+var (
+	appsecOptionFuncs = sdkOptionFuncs[appsec.Option]{
+		apiURL:        appsec.WithCortexAPIURL,
+		apiKey:        appsec.WithCortexAPIKey,
+		apiKeyID:      appsec.WithCortexAPIKeyID,
+		apiKeyType:    appsec.WithCortexAPIKeyType,
+		skipSSLVerify: appsec.WithSkipSSLVerify,
+		timeout:       appsec.WithTimeout,
+		maxRetries:    appsec.WithMaxRetries,
+		retryMaxDelay: appsec.WithRetryMaxDelay,
+		crashStackDir: appsec.WithCrashStackDir,
+		logger:        appsec.WithLogger,
+		logLevel:      appsec.WithLogLevel,
+		transport:     appsec.WithTransport,
+	}
+	cloudOnboardingOptionFuncs = sdkOptionFuncs[cloudonboarding.Option]{
+		apiURL:        cloudonboarding.WithCortexAPIURL,
+		apiKey:        cloudonboarding.WithCortexAPIKey,
+		apiKeyID:      cloudonboarding.WithCortexAPIKeyID,
+		apiKeyType:    cloudonboarding.WithCortexAPIKeyType,
+		skipSSLVerify: cloudonboarding.WithSkipSSLVerify,
+		timeout:       cloudonboarding.WithTimeout,
+		maxRetries:    cloudonboarding.WithMaxRetries,
+		retryMaxDelay: cloudonboarding.WithRetryMaxDelay,
+		crashStackDir: cloudonboarding.WithCrashStackDir,
+		logger:        cloudonboarding.WithLogger,
+		logLevel:      cloudonboarding.WithLogLevel,
+		transport:     cloudonboarding.WithTransport,
+	}
+	cloudSecOptionFuncs = sdkOptionFuncs[cloudsec.Option]{
+		apiURL:        cloudsec.WithCortexAPIURL,
+		apiKey:        cloudsec.WithCortexAPIKey,
+		apiKeyID:      cloudsec.WithCortexAPIKeyID,
+		apiKeyType:    cloudsec.WithCortexAPIKeyType,
+		skipSSLVerify: cloudsec.WithSkipSSLVerify,
+		timeout:       cloudsec.WithTimeout,
+		maxRetries:    cloudsec.WithMaxRetries,
+		retryMaxDelay: cloudsec.WithRetryMaxDelay,
+		crashStackDir: cloudsec.WithCrashStackDir,
+		logger:        cloudsec.WithLogger,
+		logLevel:      cloudsec.WithLogLevel,
+		transport:     cloudsec.WithTransport,
+	}
+	complianceOptionFuncs = sdkOptionFuncs[compliance.Option]{
+		apiURL:        compliance.WithCortexAPIURL,
+		apiKey:        compliance.WithCortexAPIKey,
+		apiKeyID:      compliance.WithCortexAPIKeyID,
+		apiKeyType:    compliance.WithCortexAPIKeyType,
+		skipSSLVerify: compliance.WithSkipSSLVerify,
+		timeout:       compliance.WithTimeout,
+		maxRetries:    compliance.WithMaxRetries,
+		retryMaxDelay: compliance.WithRetryMaxDelay,
+		crashStackDir: compliance.WithCrashStackDir,
+		logger:        compliance.WithLogger,
+		logLevel:      compliance.WithLogLevel,
+		transport:     compliance.WithTransport,
+	}
+	cwpOptionFuncs = sdkOptionFuncs[cwp.Option]{
+		apiURL:        cwp.WithCortexAPIURL,
+		apiKey:        cwp.WithCortexAPIKey,
+		apiKeyID:      cwp.WithCortexAPIKeyID,
+		apiKeyType:    cwp.WithCortexAPIKeyType,
+		skipSSLVerify: cwp.WithSkipSSLVerify,
+		timeout:       cwp.WithTimeout,
+		maxRetries:    cwp.WithMaxRetries,
+		retryMaxDelay: cwp.WithRetryMaxDelay,
+		crashStackDir: cwp.WithCrashStackDir,
+		logger:        cwp.WithLogger,
+		logLevel:      cwp.WithLogLevel,
+		transport:     cwp.WithTransport,
+	}
+	platformOptionFuncs = sdkOptionFuncs[platform.Option]{
+		apiURL:        platform.WithCortexAPIURL,
+		apiKey:        platform.WithCortexAPIKey,
+		apiKeyID:      platform.WithCortexAPIKeyID,
+		apiKeyType:    platform.WithCortexAPIKeyType,
+		skipSSLVerify: platform.WithSkipSSLVerify,
+		timeout:       platform.WithTimeout,
+		maxRetries:    platform.WithMaxRetries,
+		retryMaxDelay: platform.WithRetryMaxDelay,
+		crashStackDir: platform.WithCrashStackDir,
+		logger:        platform.WithLogger,
+		logLevel:      platform.WithLogLevel,
+		transport:     platform.WithTransport,
+	}
+	vulnerabilityOptionFuncs = sdkOptionFuncs[vulnerability.Option]{
+		apiURL:        vulnerability.WithCortexAPIURL,
+		apiKey:        vulnerability.WithCortexAPIKey,
+		apiKeyID:      vulnerability.WithCortexAPIKeyID,
+		apiKeyType:    vulnerability.WithCortexAPIKeyType,
+		skipSSLVerify: vulnerability.WithSkipSSLVerify,
+		timeout:       vulnerability.WithTimeout,
+		maxRetries:    vulnerability.WithMaxRetries,
+		retryMaxDelay: vulnerability.WithRetryMaxDelay,
+		crashStackDir: vulnerability.WithCrashStackDir,
+		logger:        vulnerability.WithLogger,
+		logLevel:      vulnerability.WithLogLevel,
+		transport:     vulnerability.WithTransport,
+	}
+)
+
+// sdkClientAPIURL returns the API URL used by an SDK client. If the given
+// endpoint override is set, it is used instead of `api_url`.
+func sdkClientAPIURL(config sdkClientConfig, endpoint types.String) string {
+	if !endpoint.IsNull() && !endpoint.IsUnknown() && endpoint.ValueString() != "" {
+		return endpoint.ValueString()
+	}
+
+	return config.apiURL
+}
+
+// sdkClientOptions returns the options for an SDK client built from the
+// shared client configuration and the given endpoint override.
+func sdkClientOptions[O any](config sdkClientConfig, endpoint types.String, funcs sdkOptionFuncs[O]) []O {
+	options := []O{
+		funcs.apiURL(sdkClientAPIURL(config, endpoint)),
+		funcs.apiKey(config.apiKey),
+		funcs.apiKeyID(config.apiKeyID),
+		funcs.apiKeyType(config.apiKeyType),
+		funcs.skipSSLVerify(config.skipSSLVerify),
+		funcs.timeout(config.timeout),
+		funcs.maxRetries(config.maxRetries),
+		funcs.retryMaxDelay(config.retryMaxDelay),
+		funcs.crashStackDir(config.crashStackDir),
+		funcs.logger(log.TflogAdapter{}),
+		funcs.logLevel(config.logLevel),
+	}
+	if config.transport != nil {
+		options = append(options, funcs.transport(config.transport))
+	}
+
+	return options
+}
+
+// NewSDKClients returns the Cortex Cloud SDK clients for each of the API
+// domains supported by the provider using the given provider configuration.
+// The clients are not initialized until they are first used, but errors in
+// the configuration shared by every client, such as an invalid proxy URL or
+// CA certificate, are returned immediately.
+func NewSDKClients(providerConfig *models.CortexCloudProviderModel) (*models.CortexCloudSDKClients, error) {
+	config, err := newSDKClientConfig(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSDKClients(config, providerConfig.Endpoints), nil
+}
+
+// newSDKClients returns the Cortex Cloud SDK clients for each of the API
+// domains supported by the provider using the given shared configuration
+// and endpoint overrides. Each client is initialized using the context of
+// the resource or data source that first requests it, as the context of the
+// provider's Configure request is no longer valid by then.
+func newSDKClients(config sdkClientConfig, endpoints *models.EndpointsModel) *models.CortexCloudSDKClients {
+	if endpoints == nil {
+		endpoints = &models.EndpointsModel{}
+	}
+
+	return &models.CortexCloudSDKClients{
+		AppSec: models.NewSDKClient("appsec", func(ctx context.Context) (*appsec.Client, error) {
+			tflog.Debug(ctx, "Initializing appsec client")
+			return appsec.NewClient(sdkClientOptions(config, endpoints.AppSec, appsecOptionFuncs)...)
+		}),
+		CloudOnboarding: models.NewSDKClient("cloudonboarding", func(ctx context.Context) (*cloudonboarding.Client, error) {
+			tflog.Debug(ctx, "Initializing cloudonboarding client")
+			return cloudonboarding.NewClient(sdkClientOptions(config, endpoints.CloudOnboarding, cloudOnboardingOptionFuncs)...)
+		}),
+		CloudSec: models.NewSDKClient("cloudsec", func(ctx context.Context) (*cloudsec.Client, error) {
+			tflog.Debug(ctx, "Initializing cloudsec client")
+			return cloudsec.NewClient(sdkClientOptions(config, endpoints.CloudSec, cloudSecOptionFuncs)...)
+		}),
+		Compliance: models.NewSDKClient("compliance", func(ctx context.Context) (*compliance.Client, error) {
+			tflog.Debug(ctx, "Initializing compliance client")
+			return compliance.NewClient(sdkClientOptions(config, endpoints.Compliance, complianceOptionFuncs)...)
+		}),
+		CWP: models.NewSDKClient("CWP", func(ctx context.Context) (*cwp.Client, error) {
+			tflog.Debug(ctx, "Initializing CWP client")
+			return cwp.NewClient(sdkClientOptions(config, endpoints.CWP, cwpOptionFuncs)...)
+		}),
+		Platform: models.NewSDKClient("platform", func(ctx context.Context) (*platform.Client, error) {
+			tflog.Debug(ctx, "Initializing platform client")
+			return platform.NewClient(sdkClientOptions(config, endpoints.Platform, platformOptionFuncs)...)
+		}),
+		Vulnerability: models.NewSDKClient("vulnerability", func(ctx context.Context) (*vulnerability.Client, error) {
+			tflog.Debug(ctx, "Initializing vulnerability client")
+			return vulnerability.NewClient(sdkClientOptions(config, endpoints.Vulnerability, vulnerabilityOptionFuncs)...)
+		}),
+	}
+}
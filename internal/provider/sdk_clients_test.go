@@ -0,0 +1,160 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cloudsecTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/cloudsec"
+
+	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCountingServer returns a test server that counts the requests it
+// receives and responds to each with an empty JSON object.
+func newCountingServer(t *testing.T, count *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, `{}`) //nolint:errcheck
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestUnitNewSDKClients_Endpoints(t *testing.T) {
+	var defaultRequests, platformRequests atomic.Int32
+	defaultServer := newCountingServer(t, &defaultRequests)
+	platformServer := newCountingServer(t, &platformRequests)
+
+	clients, err := provider.NewSDKClients(&providerModels.CortexCloudProviderModel{
+		APIURL:            types.StringValue(defaultServer.URL),
+		APIKey:            types.StringValue("test"),
+		APIKeyID:          types.Int32Value(123),
+		RequestMaxRetries: types.Int32Value(0),
+		Endpoints: &providerModels.EndpointsModel{
+			Platform: types.StringValue(platformServer.URL),
+		},
+	})
+	require.NoError(t, err)
+
+	platformClient, err := clients.Platform.Get(context.Background())
+	require.NoError(t, err)
+	cloudSecClient, err := clients.CloudSec.Get(context.Background())
+	require.NoError(t, err)
+
+	// Only the server that receives each request matters, so the errors
+	// returned when decoding the empty responses are ignored
+	_, _ = platformClient.ListUserGroups(context.Background())
+	assert.Equal(t, int32(1), platformRequests.Load(), "platform request should be sent to the platform endpoint")
+	assert.Equal(t, int32(0), defaultRequests.Load(), "platform request should not be sent to api_url")
+
+	_, _ = cloudSecClient.Search(context.Background(), cloudsecTypes.SearchRulesRequest{})
+	assert.Equal(t, int32(1), defaultRequests.Load(), "cloudsec request should be sent to api_url")
+	assert.Equal(t, int32(1), platformRequests.Load(), "cloudsec request should not be sent to the platform endpoint")
+}
+
+func TestUnitNewSDKClients_MaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, `{}`) //nolint:errcheck
+	}))
+	t.Cleanup(server.Close)
+
+	clients, err := provider.NewSDKClients(&providerModels.CortexCloudProviderModel{
+		APIURL:                types.StringValue(server.URL),
+		APIKey:                types.StringValue("test"),
+		APIKeyID:              types.Int32Value(123),
+		RequestMaxRetries:     types.Int32Value(0),
+		MaxConcurrentRequests: types.Int32Value(2),
+	})
+	require.NoError(t, err)
+
+	platformClient, err := clients.Platform.Get(context.Background())
+	require.NoError(t, err)
+	cloudSecClient, err := clients.CloudSec.Get(context.Background())
+	require.NoError(t, err)
+
+	// The limit is shared by the clients of every API domain
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if i%2 == 0 {
+				_, _ = platformClient.ListUserGroups(context.Background())
+			} else {
+				_, _ = cloudSecClient.Search(context.Background(), cloudsecTypes.SearchRulesRequest{})
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), maxInFlight.Load())
+}
+
+func TestUnitNewSDKClients_ProxyURL(t *testing.T) {
+	var proxyRequests atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Requests sent through an HTTP proxy use the absolute URL of the
+		// target server
+		if r.URL.Host == "api-cortexcloud.invalid" {
+			proxyRequests.Add(1)
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, `{}`) //nolint:errcheck
+	}))
+	t.Cleanup(proxy.Close)
+
+	clients, err := provider.NewSDKClients(&providerModels.CortexCloudProviderModel{
+		APIURL:            types.StringValue("http://api-cortexcloud.invalid"),
+		APIKey:            types.StringValue("test"),
+		APIKeyID:          types.Int32Value(123),
+		RequestMaxRetries: types.Int32Value(0),
+		ProxyURL:          types.StringValue(proxy.URL),
+	})
+	require.NoError(t, err)
+
+	platformClient, err := clients.Platform.Get(context.Background())
+	require.NoError(t, err)
+
+	_, _ = platformClient.ListUserGroups(context.Background())
+	assert.Equal(t, int32(1), proxyRequests.Load(), "request should be sent through the proxy")
+}
+
+func TestUnitNewSDKClients_InvalidTLSConfiguration(t *testing.T) {
+	_, err := provider.NewSDKClients(&providerModels.CortexCloudProviderModel{
+		APIURL:     types.StringValue("https://api-cortexcloud.invalid"),
+		APIKey:     types.StringValue("test"),
+		APIKeyID:   types.Int32Value(123),
+		CACertFile: types.StringValue(t.TempDir() + "/missing.pem"),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ca_cert_file")
+}
@@ -45,7 +45,8 @@ func NewPolicyResource() resource.Resource {
 }
 
 type policyResource struct {
-	client *appsec.Client
+	client          *appsec.Client
+	externalChanges util.ExternalChangeDetection
 }
 
 func (r *policyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -88,10 +89,12 @@ func (r *policyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"conditions": schema.StringAttribute{
 				Description: "Policy conditions as JSON-encoded string with nested AND/OR logic.",
+				CustomType:  util.NormalizedJSONType{},
 				Required:    true,
 			},
 			"scope": schema.StringAttribute{
 				Description: "Asset targeting scope as JSON-encoded string. Mutually exclusive with asset_group_ids.",
+				CustomType:  util.NormalizedJSONType{},
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
@@ -332,11 +335,13 @@ func (r *policyResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	r.client = client.AppSec
+	r.client = client.AppSec.Client(ctx, &resp.Diagnostics)
+	r.externalChanges = client.ExternalChanges
 }
 
 func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan appsecModels.PolicyModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -428,6 +433,7 @@ func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state appsecModels.PolicyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -449,16 +455,21 @@ func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	priorModifiedBy, priorModifiedAt := state.ModifiedBy, state.DateModified
 	state.RefreshFromRemote(ctx, &resp.Diagnostics, &remote)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Warn if the resource was modified outside of Terraform
+	r.externalChanges.Check(&resp.Diagnostics, "cortexcloud_appsec_policy", state.ID.ValueString(), state.ModifiedBy, state.DateModified, priorModifiedBy, priorModifiedAt)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan appsecModels.PolicyModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -553,6 +564,7 @@ func mergeServerFields(req *appsecTypes.UpdatePolicyRequest, current *appsecType
 
 func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state appsecModels.PolicyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -24,6 +24,7 @@ var (
 	_ resource.Resource                = &ruleResource{}
 	_ resource.ResourceWithConfigure   = &ruleResource{}
 	_ resource.ResourceWithImportState = &ruleResource{}
+	_ resource.ResourceWithModifyPlan  = &ruleResource{}
 )
 
 func NewRuleResource() resource.Resource {
@@ -31,7 +32,8 @@ func NewRuleResource() resource.Resource {
 }
 
 type ruleResource struct {
-	client *appsec.Client
+	client        *appsec.Client
+	defaultLabels []string
 }
 
 func (r *ruleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -78,6 +80,11 @@ func (r *ruleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"effective_labels": schema.SetAttribute{
+				Description: "All labels assigned to the rule, including the labels added by the provider's `default_labels` attribute.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"is_custom": schema.BoolAttribute{
 				Description: "Indicates whether the rule is custom.",
 				Computed:    true,
@@ -152,11 +159,17 @@ func (r *ruleResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	r.client = client.AppSec
+	r.client = client.AppSec.Client(ctx, &resp.Diagnostics)
+	r.defaultLabels = client.DefaultLabels
+}
+
+func (r *ruleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	util.ModifyPlanLabels[types.List](ctx, req, resp, r.defaultLabels)
 }
 
 func (r *ruleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan appsecModels.RuleModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -164,6 +177,9 @@ func (r *ruleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// Include the provider's default labels in the request
+	plan.EffectiveLabels = util.EffectiveLabels(ctx, plan.Labels, r.defaultLabels, &resp.Diagnostics)
+
 	createReq := plan.ToCreateRequest(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -175,16 +191,19 @@ func (r *ruleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	configuredLabels := plan.Labels
 	plan.RefreshFromRemote(ctx, &resp.Diagnostics, &result)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.Labels = util.SuppressDefaultLabels(ctx, plan.Labels, configuredLabels, r.defaultLabels, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ruleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state appsecModels.RuleModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -199,16 +218,19 @@ func (r *ruleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	configuredLabels := state.Labels
 	state.RefreshFromRemote(ctx, &resp.Diagnostics, &remote)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.Labels = util.SuppressDefaultLabels(ctx, state.Labels, configuredLabels, r.defaultLabels, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ruleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan appsecModels.RuleModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -224,6 +246,9 @@ func (r *ruleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		)
 	}
 
+	// Include the provider's default labels in the request
+	plan.EffectiveLabels = util.EffectiveLabels(ctx, plan.Labels, r.defaultLabels, &resp.Diagnostics)
+
 	updateReq := plan.ToUpdateRequest(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -235,16 +260,19 @@ func (r *ruleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	configuredLabels := plan.Labels
 	plan.RefreshFromRemote(ctx, &resp.Diagnostics, &updateResp.Rule)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.Labels = util.SuppressDefaultLabels(ctx, plan.Labels, configuredLabels, r.defaultLabels, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ruleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state appsecModels.RuleModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -0,0 +1,245 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudonboarding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/PaloAltoNetworks/cortex-cloud-go/cloudonboarding"
+	"github.com/PaloAltoNetworks/cortex-cloud-go/enums"
+	cortexTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/cloudonboarding"
+	filterTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/filter"
+
+	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/cloud_onboarding"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// destroyActionAttribute returns the schema definition for the
+// destroy_action attribute shared by the cloud integration template
+// resources.
+func destroyActionAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description:         fmt.Sprintf("The action taken against the integration instance created from this template when the resource is destroyed. If set to \"%s\", the integration instance will be deleted. If set to \"%s\", the integration instance will be disabled and remain visible in the console. Possible values are: \"%s\". Default value is \"%s\".", models.DestroyActionDelete, models.DestroyActionDisable, strings.Join(models.AllDestroyActions(), "\", \""), models.DestroyActionDisable),
+		MarkdownDescription: fmt.Sprintf("The action taken against the integration instance created from this template when the resource is destroyed. If set to `%s`, the integration instance will be deleted. If set to `%s`, the integration instance will be disabled and remain visible in the console. Possible values are: `%s`. Default value is `%s`.", models.DestroyActionDelete, models.DestroyActionDisable, strings.Join(models.AllDestroyActions(), "`, `"), models.DestroyActionDisable),
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(models.DestroyActionDisable),
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				models.AllDestroyActions()...,
+			),
+		},
+	}
+}
+
+// modifyTemplatePlan handles the plan modifications shared by the cloud
+// integration template resources.
+//
+// When the resource is planned for destruction with a destroy action of
+// "DISABLE", a warning is raised to let the practitioner know the integration
+// instance will outlive the resource. When the resource is being updated in
+// place, the attributes generated by the API on edit are marked as unknown
+// so that the new values returned by Cortex Cloud can be applied.
+func modifyTemplatePlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, generatedAttributes ...string) {
+	// If the entire plan is null, the resource is planned for destruction
+	if req.Plan.Raw.IsNull() {
+		var destroyAction types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("destroy_action"), &destroyAction)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if destroyAction.ValueString() == models.DestroyActionDisable {
+			var trackingGUID types.String
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tracking_guid"), &trackingGUID)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.AddWarning(
+				"Cloud Integration Instance Will Be Disabled",
+				fmt.Sprintf("Destroying this resource will disable the integration instance with the ID value \"%s\" instead of deleting it. You may manually delete the instance in the Cortex UI by navigating to Settings > Data Sources, right-clicking the record and clicking \"Delete\".", trackingGUID.ValueString()),
+			)
+		}
+
+		return
+	}
+
+	// Nothing else to do on create or when there are no changes to send to
+	// the API
+	if req.State.Raw.IsNull() || onlyDestroyActionChanged(ctx, &resp.Diagnostics, req.Plan, req.State) {
+		return
+	}
+
+	tflog.Debug(ctx, "Marking generated attributes as unknown for in-place update")
+	for _, attributeName := range generatedAttributes {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attributeName), types.StringUnknown())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// onlyDestroyActionChanged returns true if the given plan does not differ
+// from the given state other than in the destroy_action attribute, which is
+// only used when the resource is destroyed and is never sent to the API.
+func onlyDestroyActionChanged(ctx context.Context, diagnostics *diag.Diagnostics, plan tfsdk.Plan, state tfsdk.State) bool {
+	var destroyAction types.String
+	diagnostics.Append(state.GetAttribute(ctx, path.Root("destroy_action"), &destroyAction)...)
+	if diagnostics.HasError() {
+		return false
+	}
+
+	diagnostics.Append(plan.SetAttribute(ctx, path.Root("destroy_action"), destroyAction)...)
+	if diagnostics.HasError() {
+		return false
+	}
+
+	return plan.Raw.Equal(state.Raw)
+}
+
+// listIntegrationInstancesByIDRequest returns a request listing the
+// integration instances with the given ID.
+//
+// The instance keeps the tracking GUID of its template as its ID after the
+// template has been executed, so the status is intentionally not filtered on
+// here. This allows the resources to keep tracking (and eventually delete) the
+// integration instance once it is no longer pending.
+func listIntegrationInstancesByIDRequest(id string) *cortexTypes.ListIntegrationInstancesRequest {
+	return cortexTypes.NewListIntegrationInstancesRequest(
+		cortexTypes.WithIntegrationFilterData(
+			filterTypes.FilterData{
+				Filter: filterTypes.NewAndFilter(
+					filterTypes.NewSearchFilter(
+						enums.SearchFieldID.String(),
+						enums.SearchTypeEqualTo.String(),
+						id,
+					),
+				),
+				Paging: filterTypes.PagingFilter{
+					From: 0,
+					To:   1000,
+				},
+			},
+		),
+	)
+}
+
+// fetchIntegrationInstances executes the given request against the cloud
+// onboarding API and returns the matching integration instances.
+func fetchIntegrationInstances(ctx context.Context, diagnostics *diag.Diagnostics, sdkClient *cloudonboarding.Client, request *cortexTypes.ListIntegrationInstancesRequest) []cortexTypes.IntegrationInstance {
+	tflog.Debug(ctx, "Executing API request")
+	response, err := sdkClient.ListIntegrationInstances(ctx, request)
+	if err != nil {
+		diagnostics.AddError(
+			"Error Fetching Cloud Integration Template",
+			err.Error(),
+		)
+		return []cortexTypes.IntegrationInstance{}
+	}
+
+	return response
+}
+
+// destroyIntegrationInstances deletes or disables the given integration
+// instances according to the configured destroy action.
+func destroyIntegrationInstances(ctx context.Context, diagnostics *diag.Diagnostics, sdkClient *cloudonboarding.Client, instances []cortexTypes.IntegrationInstance, destroyAction string) {
+	if len(instances) == 0 {
+		tflog.Debug(ctx, "Integration instance not found, nothing to destroy")
+		return
+	}
+
+	instanceIDs := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		instanceIDs = append(instanceIDs, instance.ID)
+	}
+
+	switch destroyAction {
+	case models.DestroyActionDisable:
+		tflog.Debug(ctx, fmt.Sprintf("Disabling integration instances: %s", strings.Join(instanceIDs, ", ")))
+		if err := sdkClient.DisableIntegrationInstances(ctx, instanceIDs); err != nil {
+			diagnostics.AddError(
+				"Error Disabling Cloud Integration Instance",
+				err.Error(),
+			)
+		}
+	default:
+		tflog.Debug(ctx, fmt.Sprintf("Deleting integration instances: %s", strings.Join(instanceIDs, ", ")))
+		if err := sdkClient.DeleteIntegrationInstances(ctx, instanceIDs); err != nil {
+			diagnostics.AddError(
+				"Error Deleting Cloud Integration Instance",
+				err.Error(),
+			)
+		}
+	}
+}
+
+// findImportedIntegrationInstance resolves the identifier supplied when
+// importing a cloud integration template resource, which may either be the
+// tracking GUID of a template or the ID of an integration instance that was
+// onboarded in the console, and returns the details of the matching
+// integration instance.
+func findImportedIntegrationInstance(ctx context.Context, diagnostics *diag.Diagnostics, sdkClient *cloudonboarding.Client, importID string, cloudProvider string) cortexTypes.IntegrationInstance {
+	tflog.Debug(ctx, "Looking up integration instance by tracking GUID")
+	instances := fetchIntegrationInstances(ctx, diagnostics, sdkClient, listIntegrationInstancesByIDRequest(importID))
+	if diagnostics.HasError() {
+		return cortexTypes.IntegrationInstance{}
+	}
+
+	if len(instances) > 1 {
+		diagnostics.AddError(
+			"Multiple Cloud Integration Templates Returned",
+			fmt.Sprintf("Cortex Cloud returned multiple results for the tracking GUID \"%s\". Please report this issue to the provider developers.", importID),
+		)
+		return cortexTypes.IntegrationInstance{}
+	}
+
+	instanceID := importID
+	if len(instances) == 1 {
+		instanceID = instances[0].ID
+	} else {
+		tflog.Debug(ctx, "No template found for tracking GUID, looking up integration instance by ID")
+	}
+
+	tflog.Debug(ctx, "Fetching integration instance details")
+	instance, err := sdkClient.GetIntegrationInstanceDetails(ctx, instanceID)
+	if err != nil {
+		diagnostics.AddError(
+			"Error Importing Cloud Integration Template",
+			fmt.Sprintf("Unable to find an integration instance with the tracking GUID or instance ID \"%s\": %s", importID, err.Error()),
+		)
+		return cortexTypes.IntegrationInstance{}
+	}
+
+	// The details endpoint does not always populate every field returned by
+	// the list endpoint, so fill in any gaps from the list result.
+	if instance.ID == "" {
+		instance.ID = instanceID
+	}
+	if len(instances) == 1 && instance.OutpostID == "" {
+		instance.OutpostID = instances[0].OutpostID
+	}
+
+	if !strings.EqualFold(instance.CloudProvider, cloudProvider) {
+		diagnostics.AddError(
+			"Error Importing Cloud Integration Template",
+			fmt.Sprintf("The integration instance \"%s\" belongs to the cloud provider \"%s\" and cannot be imported as a %s template.", importID, instance.CloudProvider, cloudProvider),
+		)
+		return cortexTypes.IntegrationInstance{}
+	}
+
+	return instance
+}
@@ -0,0 +1,411 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudonboarding
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/cortex-cloud-go/cloudonboarding"
+	"github.com/PaloAltoNetworks/cortex-cloud-go/enums"
+	cortexTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/cloudonboarding"
+
+	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/cloud_onboarding"
+	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// cloudIntegrationTemplateModel is implemented by the model of each
+// cloud-specific integration template resource.
+type cloudIntegrationTemplateModel interface {
+	Template() *models.CloudIntegrationTemplateModel
+	ToCreateRequest(ctx context.Context, diagnostics *diag.Diagnostics) *cortexTypes.CreateIntegrationTemplateRequest
+	ToEditRequest(ctx context.Context, diagnostics *diag.Diagnostics) *cortexTypes.EditIntegrationInstanceRequest
+	SetGeneratedValues(ctx context.Context, diagnostics *diag.Diagnostics, response cortexTypes.CreateTemplateOrEditIntegrationInstanceResponse)
+	RefreshConfiguredPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, remote cortexTypes.IntegrationInstance)
+	RefreshImportedPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, remote cortexTypes.IntegrationInstance)
+}
+
+// cloudIntegrationTemplateResource implements the lifecycle shared by the
+// cloud integration template resources. The cloud-specific behavior is
+// supplied by the model type M and the fields of the resource.
+type cloudIntegrationTemplateResource[M any, PM interface {
+	*M
+	cloudIntegrationTemplateModel
+}] struct {
+	client *cloudonboarding.Client
+
+	// typeName is the resource type name without the provider prefix.
+	typeName string
+	// cloudProvider is the cloud service provider of the integration
+	// instances managed by the resource.
+	cloudProvider string
+	// cloudName is the display name of the cloud service provider.
+	cloudName string
+	// description is the description of the resource.
+	description string
+	// attributes returns the cloud-specific attributes of the resource.
+	// They are merged with the attributes shared by every cloud integration
+	// template resource.
+	attributes func() map[string]schema.Attribute
+	// generatedAttributes lists the attributes generated by the API whenever
+	// the integration instance is created or edited.
+	generatedAttributes []string
+}
+
+// Metadata returns the resource type name.
+func (r *cloudIntegrationTemplateResource[M, PM]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+// Schema defines the schema for the resource.
+func (r *cloudIntegrationTemplateResource[M, PM]) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := templateAttributes(r.cloudName)
+	for name, attribute := range r.attributes() {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Description: r.description,
+		Attributes:  attributes,
+	}
+}
+
+// Configure adds the provider-configured client to the resource.
+func (r *cloudIntegrationTemplateResource[M, PM]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "resource_type", r.typeName)
+	ctx = tflog.SetField(ctx, "resource_operation", "Configure")
+	tflog.Debug(ctx, "Configuring SDK client")
+
+	client, ok := req.ProviderData.(*providerModels.CortexCloudSDKClients)
+
+	if !ok {
+		util.AddUnexpectedResourceConfigurationTypeError(&resp.Diagnostics, "*providerModels.CortexCloudSDKClients", req.ProviderData)
+		return
+	}
+
+	r.client = client.CloudOnboarding.Client(ctx, &resp.Diagnostics)
+}
+
+// ModifyPlan modifies the plan for the resource.
+func (r *cloudIntegrationTemplateResource[M, PM]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx = tflog.SetField(ctx, "resource_type", r.typeName)
+	ctx = tflog.SetField(ctx, "resource_operation", "ModifyPlan")
+	tflog.Debug(ctx, "Executing ModifyPlan")
+
+	modifyTemplatePlan(ctx, req, resp, append([]string{"status"}, r.generatedAttributes...)...)
+}
+
+// fetchTemplate retrieves the integration instances matching the tracking
+// GUID of the given model.
+func (r *cloudIntegrationTemplateResource[M, PM]) fetchTemplate(ctx context.Context, diagnostics *diag.Diagnostics, model PM) []cortexTypes.IntegrationInstance {
+	tflog.Debug(ctx, "Generating fetch API request payload")
+	request := listIntegrationInstancesByIDRequest(model.Template().TrackingGUID.ValueString())
+
+	return fetchIntegrationInstances(ctx, diagnostics, r.client, request)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *cloudIntegrationTemplateResource[M, PM]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	ctx = tflog.SetField(ctx, "resource_type", r.typeName)
+	ctx = tflog.SetField(ctx, "resource_operation", "Create")
+
+	// Retrieve values from plan
+	tflog.Debug(ctx, "Retrieving values from plan")
+	var plan M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model := PM(&plan)
+	template := model.Template()
+
+	tflog.Debug(ctx, "Generating API request payload")
+	createRequest := model.ToCreateRequest(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Executing API request")
+	createResponse, err := r.client.CreateIntegrationTemplate(ctx, createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Cloud Integration Template",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values,
+	// including the tracking GUID parsed from the response
+	tflog.Debug(ctx, "Setting computed attributes")
+	model.SetGeneratedValues(ctx, &resp.Diagnostics, createResponse)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch the created template and populate OutpostID and Status
+	if !template.TrackingGUID.IsNull() && template.TrackingGUID.ValueString() != "" {
+		tflog.Debug(ctx, "Fetching created template")
+		response := r.fetchTemplate(ctx, &resp.Diagnostics, model)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if len(response) == 1 && response[0].OutpostID != "" {
+			template.OutpostID = types.StringValue(response[0].OutpostID)
+		} else {
+			template.OutpostID = types.StringNull()
+		}
+
+		if len(response) == 1 {
+			template.Status = types.StringValue(response[0].Status)
+		}
+	} else {
+		tflog.Debug(ctx, "No Tracking GUID found")
+
+		template.TrackingGUID = types.StringNull()
+		template.OutpostID = types.StringNull()
+	}
+
+	if template.Status.IsUnknown() {
+		template.Status = types.StringValue(enums.IntegrationInstanceStatusPending.String())
+	}
+
+	// Set state to fully populated data
+	tflog.Debug(ctx, "Setting state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest values.
+func (r *cloudIntegrationTemplateResource[M, PM]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	ctx = tflog.SetField(ctx, "resource_type", r.typeName)
+	ctx = tflog.SetField(ctx, "resource_id_field", "tracking_guid")
+	ctx = tflog.SetField(ctx, "resource_operation", "Read")
+
+	// Retrieve values from state
+	tflog.Debug(ctx, "Retrieving values from state")
+	var state M
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model := PM(&state)
+
+	ctx = tflog.SetField(ctx, "resource_id_value", model.Template().TrackingGUID.ValueString())
+
+	// Retrieve integration details from API
+	tflog.Debug(ctx, "Fetching template")
+	response := r.fetchTemplate(ctx, &resp.Diagnostics, model)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(response) == 0 {
+		tflog.Debug(ctx, "Template not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if len(response) > 1 {
+		tflog.Debug(ctx, "API returned multiple results")
+		resp.Diagnostics.AddWarning(
+			"Multiple Cloud Integration Templates Returned",
+			"Cortex Cloud returned multiple results for this resource. "+
+				"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	// Refresh state values
+	tflog.Debug(ctx, "Refreshing configured attributes")
+	model.RefreshConfiguredPropertyValues(ctx, &resp.Diagnostics, response[0])
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resources created before destroy_action was added have no value in
+	// state, so the default is stored to avoid planning an update
+	if model.Template().DestroyAction.IsNull() {
+		model.Template().DestroyAction = types.StringValue(models.DestroyActionDisable)
+	}
+
+	// Set refreshed state
+	tflog.Debug(ctx, "Setting refreshed state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *cloudIntegrationTemplateResource[M, PM]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	ctx = tflog.SetField(ctx, "resource_type", r.typeName)
+	ctx = tflog.SetField(ctx, "resource_id_field", "tracking_guid")
+	ctx = tflog.SetField(ctx, "resource_operation", "Update")
+
+	// Retrieve values from plan and state
+	tflog.Debug(ctx, "Retrieving values from plan and state")
+	var plan, state M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model := PM(&plan)
+	template := model.Template()
+	stateTemplate := PM(&state).Template()
+
+	ctx = tflog.SetField(ctx, "resource_id_value", stateTemplate.TrackingGUID.ValueString())
+
+	// Changing destroy_action alone does not require the integration
+	// instance to be edited, which would regenerate its deployment URLs
+	if onlyDestroyActionChanged(ctx, &resp.Diagnostics, req.Plan, req.State) {
+		tflog.Debug(ctx, "Only destroy_action changed, skipping API request")
+		stateTemplate.DestroyAction = template.DestroyAction
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	template.TrackingGUID = stateTemplate.TrackingGUID
+
+	tflog.Debug(ctx, "Generating API request payload")
+	editRequest := model.ToEditRequest(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Executing API request")
+	editResponse, err := r.client.EditIntegrationInstance(ctx, editRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Cloud Integration Template",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	tflog.Debug(ctx, "Setting computed attributes")
+	model.SetGeneratedValues(ctx, &resp.Diagnostics, editResponse)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Editing an integration instance never changes its tracking GUID
+	template.TrackingGUID = stateTemplate.TrackingGUID
+
+	// Fetch the updated template and populate OutpostID and Status
+	tflog.Debug(ctx, "Fetching updated template")
+	response := r.fetchTemplate(ctx, &resp.Diagnostics, model)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	template.Status = stateTemplate.Status
+	if len(response) == 1 {
+		template.Status = types.StringValue(response[0].Status)
+		if response[0].OutpostID != "" {
+			template.OutpostID = types.StringValue(response[0].OutpostID)
+		}
+	}
+
+	// Set state to fully populated data
+	tflog.Debug(ctx, "Setting state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes it from the Terraform state on success.
+func (r *cloudIntegrationTemplateResource[M, PM]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	ctx = tflog.SetField(ctx, "resource_type", r.typeName)
+	ctx = tflog.SetField(ctx, "resource_id_field", "tracking_guid")
+	ctx = tflog.SetField(ctx, "resource_operation", "Delete")
+
+	// Retrieve values from state
+	tflog.Debug(ctx, "Retrieving values from state")
+	var state M
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model := PM(&state)
+	template := model.Template()
+
+	ctx = tflog.SetField(ctx, "resource_id_value", template.TrackingGUID.ValueString())
+
+	if template.TrackingGUID.IsNull() || template.TrackingGUID.ValueString() == "" {
+		tflog.Debug(ctx, "No tracking GUID found, removing from state")
+		return
+	}
+
+	tflog.Debug(ctx, "Fetching template")
+	response := r.fetchTemplate(ctx, &resp.Diagnostics, model)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destroyIntegrationInstances(ctx, &resp.Diagnostics, r.client, response, template.DestroyAction.ValueString())
+}
+
+// ImportState imports the resource by either the tracking GUID of the
+// template or the ID of the integration instance.
+func (r *cloudIntegrationTemplateResource[M, PM]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	ctx = tflog.SetField(ctx, "resource_type", r.typeName)
+	ctx = tflog.SetField(ctx, "resource_id_field", "tracking_guid")
+	ctx = tflog.SetField(ctx, "resource_id_value", req.ID)
+	ctx = tflog.SetField(ctx, "resource_operation", "ImportState")
+
+	instance := findImportedIntegrationInstance(ctx, &resp.Diagnostics, r.client, req.ID, r.cloudProvider)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The state is null when importing, so the identifier is set first to
+	// allow the remaining attributes to be retrieved as typed null values.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tracking_guid"), instance.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state M
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Refreshing imported attributes")
+	PM(&state).RefreshImportedPropertyValues(ctx, &resp.Diagnostics, instance)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Setting imported state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,598 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudonboarding_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+const testTrackingGUID = "a1b2c3d4e5f60718293a4b5c6d7e8f90"
+
+// integrationInstanceServer is a mock of the cloud onboarding API that keeps
+// the integration instances created from templates in memory and records the
+// requests made against them.
+type integrationInstanceServer struct {
+	mu sync.Mutex
+
+	// instances holds the integration instances returned by the list and
+	// details endpoints, keyed by ID.
+	instances map[string]map[string]any
+	// consoleInstances holds the integration instances onboarded in the
+	// console, which are only returned by the details endpoint.
+	consoleInstances map[string]map[string]any
+	// omitTrackingGUIDOnEdit removes the tracking GUID from the response
+	// returned when an integration instance is edited.
+	omitTrackingGUIDOnEdit bool
+
+	edited   []string
+	deleted  []string
+	disabled []string
+}
+
+func newIntegrationInstanceServer(t *testing.T) (*integrationInstanceServer, *httptest.Server) {
+	t.Helper()
+
+	mock := &integrationInstanceServer{
+		instances:        map[string]map[string]any{},
+		consoleInstances: map[string]map[string]any{},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mock.mu.Lock()
+		defer mock.mu.Unlock()
+
+		body, _ := io.ReadAll(r.Body)
+		var payload any
+		_ = json.Unmarshal(body, &payload)
+
+		path := r.URL.Path
+		switch {
+		case r.Method == http.MethodPost && strings.Contains(path, "create_instance_template"):
+			instance := map[string]any{
+				"id":             testTrackingGUID,
+				"cloud_provider": "AWS",
+				"status":         "PENDING",
+				"outpost_id":     "outpost-1",
+			}
+			mock.applyTemplate(instance, payload)
+			mock.instances[testTrackingGUID] = instance
+
+			writeReply(w, templateResponse(true))
+		case r.Method == http.MethodPost && strings.Contains(path, "edit_instance"):
+			id := mock.matchIDs(body)
+			if len(id) != 1 {
+				http.Error(w, fmt.Sprintf("[%s] Integration instance not found", t.Name()), http.StatusNotFound)
+				return
+			}
+			mock.edited = append(mock.edited, id[0])
+			mock.applyTemplate(mock.instances[id[0]], payload)
+
+			writeReply(w, templateResponse(!mock.omitTrackingGUIDOnEdit))
+		case r.Method == http.MethodPost && strings.Contains(path, "get_instance_details"):
+			for id, instance := range mock.consoleInstances {
+				if bytes.Contains(body, []byte(`"`+id+`"`)) {
+					writeReply(w, instance)
+					return
+				}
+			}
+
+			id := mock.matchIDs(body)
+			if len(id) != 1 {
+				http.Error(w, fmt.Sprintf("[%s] Integration instance not found", t.Name()), http.StatusNotFound)
+				return
+			}
+
+			writeReply(w, mock.instances[id[0]])
+		case r.Method == http.MethodPost && strings.Contains(path, "get_instances"):
+			data := []map[string]any{}
+			for _, id := range mock.matchIDs(body) {
+				data = append(data, mock.instances[id])
+			}
+
+			writeReply(w, map[string]any{
+				"DATA":         data,
+				"FILTER_COUNT": len(data),
+				"TOTAL_COUNT":  len(data),
+			})
+		case r.Method == http.MethodPost && strings.Contains(path, "delete_instances"):
+			for _, id := range mock.matchIDs(body) {
+				mock.deleted = append(mock.deleted, id)
+				delete(mock.instances, id)
+			}
+
+			writeReply(w, map[string]any{})
+		case r.Method == http.MethodPost && strings.Contains(path, "enable_disable"):
+			for _, id := range mock.matchIDs(body) {
+				mock.disabled = append(mock.disabled, id)
+				mock.instances[id]["status"] = "DISABLED"
+			}
+
+			writeReply(w, map[string]any{})
+		default:
+			http.Error(w, fmt.Sprintf("[%s] Endpoint not found: %s %s", t.Name(), r.Method, path), http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return mock, server
+}
+
+// matchIDs returns the IDs of the known integration instances referenced in
+// the given request body, in sorted order.
+func (m *integrationInstanceServer) matchIDs(body []byte) []string {
+	ids := []string{}
+	for id := range m.instances {
+		if bytes.Contains(body, []byte(`"`+id+`"`)) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	return ids
+}
+
+// applyTemplate copies the configured template values from the given request
+// payload to the integration instance.
+func (m *integrationInstanceServer) applyTemplate(instance map[string]any, payload any) {
+	for _, key := range []string{"additional_capabilities", "collection_configuration", "custom_resources_tags", "instance_name", "scope"} {
+		if value, ok := findJSONValue(payload, key); ok {
+			instance[key] = value
+		}
+	}
+	if value, ok := findJSONValue(payload, "scan_mode"); ok {
+		instance["scan"] = map[string]any{"scan_method": value}
+	}
+	if value, ok := findJSONValue(payload, "outpost_id"); ok {
+		instance["outpost_id"] = value
+	}
+}
+
+func (m *integrationInstanceServer) requests() (edited, deleted, disabled []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.edited), slices.Clone(m.deleted), slices.Clone(m.disabled)
+}
+
+// findJSONValue returns the first value stored under the given key in the
+// decoded JSON document, searching nested objects and arrays.
+func findJSONValue(document any, key string) (any, bool) {
+	switch value := document.(type) {
+	case map[string]any:
+		if v, ok := value[key]; ok {
+			return v, true
+		}
+		for _, nested := range value {
+			if v, ok := findJSONValue(nested, key); ok {
+				return v, true
+			}
+		}
+	case []any:
+		for _, nested := range value {
+			if v, ok := findJSONValue(nested, key); ok {
+				return v, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func templateResponse(includeTrackingGUID bool) map[string]any {
+	automated := map[string]any{
+		"link": "https://console.aws.amazon.com/cloudformation/home#/stacks/quickcreate?stackName=cortex-cloud",
+	}
+	if includeTrackingGUID {
+		automated["tracking_guid"] = testTrackingGUID
+	}
+
+	return map[string]any{
+		"automated": automated,
+		"manual": map[string]any{
+			"CF": "https://console.aws.amazon.com/cloudformation/home#/stacks/quickcreate?templateURL=https%3A%2F%2Fcortex-templates.s3.amazonaws.com%2Ftemplate.json&stackName=cortex-cloud",
+		},
+	}
+}
+
+func writeReply(w http.ResponseWriter, reply any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]any{"reply": reply})
+}
+
+func testAccCloudIntegrationTemplateAwsConfig(serverURL, instanceName, destroyAction string) string {
+	destroyActionConfig := ""
+	if destroyAction != "" {
+		destroyActionConfig = fmt.Sprintf("destroy_action = %q", destroyAction)
+	}
+
+	return fmt.Sprintf(`
+		provider "cortexcloud" {
+			api_url    = "%s"
+			api_key    = "test"
+			api_key_id = 123
+		}
+
+		resource "cortexcloud_cloud_integration_template_aws" "test" {
+			scope         = "ACCOUNT"
+			scan_mode     = "MANAGED"
+			instance_name = "%s"
+			scope_modifications = {
+				regions = {
+					enabled = false
+				}
+			}
+			%s
+		}
+	`, serverURL, instanceName, destroyActionConfig)
+}
+
+func providerFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+	}
+}
+
+func TestUnitCloudIntegrationTemplateResource_DestroyDelete(t *testing.T) {
+	mock, server := newIntegrationInstanceServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy: func(s *terraform.State) error {
+			_, deleted, disabled := mock.requests()
+			if !slices.Equal(deleted, []string{testTrackingGUID}) {
+				return fmt.Errorf("expected integration instance %q to be deleted, got %v", testTrackingGUID, deleted)
+			}
+			if len(disabled) != 0 {
+				return fmt.Errorf("expected no integration instance to be disabled, got %v", disabled)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudIntegrationTemplateAwsConfig(server.URL, "AWS Account", "DELETE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "tracking_guid", testTrackingGUID),
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "destroy_action", "DELETE"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCloudIntegrationTemplateResource_DestroyDisable(t *testing.T) {
+	mock, server := newIntegrationInstanceServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy: func(s *terraform.State) error {
+			_, deleted, disabled := mock.requests()
+			if !slices.Equal(disabled, []string{testTrackingGUID}) {
+				return fmt.Errorf("expected integration instance %q to be disabled, got %v", testTrackingGUID, disabled)
+			}
+			if len(deleted) != 0 {
+				return fmt.Errorf("expected no integration instance to be deleted, got %v", deleted)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudIntegrationTemplateAwsConfig(server.URL, "AWS Account", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "destroy_action", "DISABLE"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCloudIntegrationTemplateResource_DestroyInstanceGone(t *testing.T) {
+	mock, server := newIntegrationInstanceServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy: func(s *terraform.State) error {
+			_, deleted, disabled := mock.requests()
+			if len(deleted) != 0 || len(disabled) != 0 {
+				return fmt.Errorf("expected no request for a deleted integration instance, got deleted %v and disabled %v", deleted, disabled)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudIntegrationTemplateAwsConfig(server.URL, "AWS Account", ""),
+				Check: func(s *terraform.State) error {
+					// Simulate the instance being deleted in the console
+					mock.mu.Lock()
+					defer mock.mu.Unlock()
+					delete(mock.instances, testTrackingGUID)
+					return nil
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestUnitCloudIntegrationTemplateResource_Update(t *testing.T) {
+	mock, server := newIntegrationInstanceServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudIntegrationTemplateAwsConfig(server.URL, "AWS Account", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "instance_name", "AWS Account"),
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "tracking_guid", testTrackingGUID),
+				),
+			},
+			{
+				PreConfig: func() {
+					mock.mu.Lock()
+					defer mock.mu.Unlock()
+					mock.omitTrackingGUIDOnEdit = true
+				},
+				Config: testAccCloudIntegrationTemplateAwsConfig(server.URL, "Renamed AWS Account", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "instance_name", "Renamed AWS Account"),
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "tracking_guid", testTrackingGUID),
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "status", "PENDING"),
+					func(s *terraform.State) error {
+						edited, _, _ := mock.requests()
+						if !slices.Equal(edited, []string{testTrackingGUID}) {
+							return fmt.Errorf("expected integration instance %q to be edited once, got %v", testTrackingGUID, edited)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCloudIntegrationTemplateResource_UpdateDestroyAction(t *testing.T) {
+	mock, server := newIntegrationInstanceServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy: func(s *terraform.State) error {
+			_, deleted, _ := mock.requests()
+			if !slices.Equal(deleted, []string{testTrackingGUID}) {
+				return fmt.Errorf("expected integration instance %q to be deleted, got %v", testTrackingGUID, deleted)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudIntegrationTemplateAwsConfig(server.URL, "AWS Account", ""),
+			},
+			// Changing only destroy_action does not edit the integration
+			// instance or regenerate the deployment URLs
+			{
+				Config: testAccCloudIntegrationTemplateAwsConfig(server.URL, "AWS Account", "DELETE"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("cortexcloud_cloud_integration_template_aws.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("cortexcloud_cloud_integration_template_aws.test", tfjsonpath.New("automated_deployment_url"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "destroy_action", "DELETE"),
+					resource.TestCheckResourceAttrSet("cortexcloud_cloud_integration_template_aws.test", "automated_deployment_url"),
+					func(s *terraform.State) error {
+						edited, _, _ := mock.requests()
+						if len(edited) != 0 {
+							return fmt.Errorf("expected no integration instance to be edited, got %v", edited)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCloudIntegrationTemplateResource_ImportByTrackingGUID(t *testing.T) {
+	_, server := newIntegrationInstanceServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudIntegrationTemplateAwsConfig(server.URL, "AWS Account", ""),
+			},
+			{
+				Config:                               testAccCloudIntegrationTemplateAwsConfig(server.URL, "AWS Account", ""),
+				ResourceName:                         "cortexcloud_cloud_integration_template_aws.test",
+				ImportState:                          true,
+				ImportStateId:                        testTrackingGUID,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "tracking_guid",
+				// Values only returned when the template is created are not
+				// available on import
+				ImportStateVerifyIgnore: []string{
+					"automated_deployment_url",
+					"manual_deployment_url",
+					"cloudformation_template_url",
+					"scope_modifications",
+				},
+			},
+		},
+	})
+}
+
+func TestUnitCloudIntegrationTemplateResource_ImportByInstanceID(t *testing.T) {
+	mock, server := newIntegrationInstanceServer(t)
+	mock.consoleInstances["console-instance-1"] = map[string]any{
+		"id":             "console-instance-1",
+		"cloud_provider": "AWS",
+		"instance_name":  "AWS Account",
+		"scope":          "ACCOUNT",
+		"status":         "CONNECTED",
+		"outpost_id":     "outpost-1",
+		"scan": map[string]any{
+			"scan_method": "MANAGED",
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccCloudIntegrationTemplateAwsConfig(server.URL, "AWS Account", ""),
+				ResourceName:  "cortexcloud_cloud_integration_template_aws.test",
+				ImportState:   true,
+				ImportStateId: "console-instance-1",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+					}
+
+					expected := map[string]string{
+						"tracking_guid":  "console-instance-1",
+						"instance_name":  "AWS Account",
+						"scope":          "ACCOUNT",
+						"scan_mode":      "MANAGED",
+						"status":         "CONNECTED",
+						"outpost_id":     "outpost-1",
+						"destroy_action": "DISABLE",
+					}
+					for name, value := range expected {
+						if actual := states[0].Attributes[name]; actual != value {
+							return fmt.Errorf("expected %s to be %q, got %q", name, value, actual)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestUnitCloudIntegrationTemplateResource_ImportWrongCloudProvider(t *testing.T) {
+	mock, server := newIntegrationInstanceServer(t)
+	mock.consoleInstances["console-instance-1"] = map[string]any{
+		"id":             "console-instance-1",
+		"cloud_provider": "GCP",
+		"scope":          "PROJECT",
+		"status":         "CONNECTED",
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccCloudIntegrationTemplateAwsConfig(server.URL, "AWS Account", ""),
+				ResourceName:  "cortexcloud_cloud_integration_template_aws.test",
+				ImportState:   true,
+				ImportStateId: "console-instance-1",
+				ExpectError:   regexp.MustCompile(`belongs to the cloud provider\s+"GCP"`),
+			},
+		},
+	})
+}
+
+func TestUnitCloudIntegrationTemplateResource_Lifecycle(t *testing.T) {
+	mock, server := newIntegrationInstanceServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy: func(s *terraform.State) error {
+			_, deleted, _ := mock.requests()
+			if !slices.Equal(deleted, []string{testTrackingGUID}) {
+				return fmt.Errorf("expected integration instance %q to be deleted, got %v", testTrackingGUID, deleted)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: testAccCloudIntegrationTemplateAwsConfig(server.URL, "AWS Account", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "tracking_guid", testTrackingGUID),
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "status", "PENDING"),
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "outpost_id", "outpost-1"),
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "scope", "ACCOUNT"),
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "scan_mode", "MANAGED"),
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "cloudformation_template_url", "https://cortex-templates.s3.amazonaws.com/template.json"),
+					resource.TestCheckResourceAttrSet("cortexcloud_cloud_integration_template_aws.test", "automated_deployment_url"),
+					resource.TestCheckResourceAttrSet("cortexcloud_cloud_integration_template_aws.test", "manual_deployment_url"),
+				),
+			},
+			// Read picks up the status of the executed template
+			{
+				PreConfig: func() {
+					mock.mu.Lock()
+					defer mock.mu.Unlock()
+					mock.instances[testTrackingGUID]["status"] = "CONNECTED"
+				},
+				Config: testAccCloudIntegrationTemplateAwsConfig(server.URL, "AWS Account", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "status", "CONNECTED"),
+				),
+			},
+			// Update keeps the outpost ID, which is not returned by the edit
+			// response
+			{
+				Config: testAccCloudIntegrationTemplateAwsConfig(server.URL, "Renamed AWS Account", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("cortexcloud_cloud_integration_template_aws.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("cortexcloud_cloud_integration_template_aws.test", tfjsonpath.New("outpost_id"), knownvalue.StringExact("outpost-1")),
+						plancheck.ExpectUnknownValue("cortexcloud_cloud_integration_template_aws.test", tfjsonpath.New("automated_deployment_url")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "instance_name", "Renamed AWS Account"),
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "outpost_id", "outpost-1"),
+					resource.TestCheckResourceAttr("cortexcloud_cloud_integration_template_aws.test", "status", "CONNECTED"),
+				),
+			},
+			// Import
+			{
+				Config:                               testAccCloudIntegrationTemplateAwsConfig(server.URL, "Renamed AWS Account", ""),
+				ResourceName:                         "cortexcloud_cloud_integration_template_aws.test",
+				ImportState:                          true,
+				ImportStateId:                        testTrackingGUID,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "tracking_guid",
+				ImportStateVerifyIgnore: []string{
+					"automated_deployment_url",
+					"manual_deployment_url",
+					"cloudformation_template_url",
+					"scope_modifications",
+				},
+			},
+		},
+	})
+}
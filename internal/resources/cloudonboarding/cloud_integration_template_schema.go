@@ -0,0 +1,537 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudonboarding
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PaloAltoNetworks/cortex-cloud-go/enums"
+
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// templateAttributes returns the attributes shared by every cloud integration
+// template resource. The cloud name is used in the attribute descriptions.
+func templateAttributes(cloudName string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"additional_capabilities": schema.SingleNestedAttribute{
+			Description: "Define which additional security capabilities to enable. " +
+				"\n\n~>**NOTE**: adding additional capabilities " +
+				"typically requires additional cloud provider " +
+				"permissions. For more information, refer to the Cortex " +
+				"Cloud Posture Management documentation: " +
+				"https://docs-cortex.paloaltonetworks.com/r/Cortex-CLOUD/Cortex-Cloud-Posture-Management-Documentation/Cloud-service-provider-permissions",
+			MarkdownDescription: "Define which additional security capabilities to enable. \n\n~>**NOTE** that adding additional capabilities typically requires additional cloud provider permissions. For more information, refer to the [Cortex Cloud Posture Management documentation](https://docs-cortex.paloaltonetworks.com/r/Cortex-CLOUD/Cortex-Cloud-Posture-Management-Documentation/Cloud-service-provider-permissions).",
+			Optional:            true,
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"data_security_posture_management": schema.BoolAttribute{
+					Description: "Whether to enable data security " +
+						"posture management, an agentless data security " +
+						"scanner that discovers, classifies, protects, " +
+						"and governs sensitive data. Default value is \"true\".",
+					MarkdownDescription: "Whether to enable data security " +
+						"posture management, an agentless data security " +
+						"scanner that discovers, classifies, protects, " +
+						"and governs sensitive data. Default value is `true`.",
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(true),
+				},
+				"registry_scanning": schema.BoolAttribute{
+					Description: "Whether to enable registry scanning, " +
+						"a container registry scanner that scans " +
+						"registry images for vulnerabilities, malware, " +
+						"and secrets. Default value is \"true\".",
+					MarkdownDescription: "Whether to enable registry scanning, " +
+						"a container registry scanner that scans " +
+						"registry images for vulnerabilities, malware, " +
+						"and secrets. Default value is `true`.",
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(true),
+				},
+				"registry_scanning_options": schema.SingleNestedAttribute{
+					Description:         "Additional configuration options for registry scanning.",
+					MarkdownDescription: "Additional configuration options for registry scanning.",
+					Optional:            true,
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of registry scanning.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									enums.AllRegistryScanningTypes()...,
+								),
+								validators.AlsoRequiresOnStringValues(
+									[]string{
+										enums.RegistryScanningTypeTagsModifiedDays.String(),
+									},
+									path.MatchRelative().AtParent().AtName("last_days"),
+								),
+							},
+						},
+						"last_days": schema.Int32Attribute{
+							Description: "Number of days within which " +
+								"the tags on a registry image must have " +
+								"been created or updated for the image " +
+								"to be scanned. Minimum value is 0 and " +
+								"maximum value is 90. Cannot be " +
+								"configured if \"type\" is not set to " +
+								"\"TAGS_MODIFIED_DAYS\".",
+							MarkdownDescription: "Number of days within which " +
+								"the tags on a registry image must have " +
+								"been created or updated for the image " +
+								"to be scanned. Minimum value is 0 and " +
+								"maximum value is 90. Cannot be " +
+								"configured if `type` is not set to " +
+								"`TAGS_MODIFIED_DAYS`.",
+							Optional: true,
+							Validators: []validator.Int32{
+								int32validator.Between(0, 90),
+							},
+						},
+					},
+					Default: objectdefault.StaticValue(
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"type":      types.StringType,
+								"last_days": types.Int32Type,
+							},
+							map[string]attr.Value{
+								"type":      types.StringValue(enums.RegistryScanningTypeAll.String()),
+								"last_days": types.Int32Null(),
+							},
+						),
+					),
+				},
+				"agentless_disk_scanning": schema.BoolAttribute{
+					Description: "Whether to enable agentless disk " +
+						"scanning to remotely detect and remediate " +
+						"vulnerabilities during the development " +
+						"lifecycle. Default value is \"true\".",
+					MarkdownDescription: "Whether to enable agentless disk " +
+						"scanning to remotely detect and remediate " +
+						"vulnerabilities during the development " +
+						"lifecycle. Default value is `true`.",
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(true),
+				},
+				"xsiam_analytics": schema.BoolAttribute{
+					Description: "Whether to enable XSIAM analytics to " +
+						"analyze your endpoint data to develop a " +
+						"baseline and raise Analytics and Analytics " +
+						"BIOC alerts when anomalies and malicious " +
+						"behaviors are detected. Default value is \"true\".",
+					MarkdownDescription: "Whether to enable XSIAM analytics to " +
+						"analyze your endpoint data to develop a " +
+						"baseline and raise Analytics and Analytics " +
+						"BIOC alerts when anomalies and malicious " +
+						"behaviors are detected. Default value is `true`.",
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(true),
+				},
+				"serverless_scanning": schema.BoolAttribute{
+					Description: "Whether to enable serverless scanning to detect and remediate vulnerabilities within serverless functions during the development lifecycle. Default value is \"true\".",
+					MarkdownDescription: "Whether to enable agentless disk " +
+						"scanning to remotely detect and remediate " +
+						"vulnerabilities during the development " +
+						"lifecycle. Default value is `true`.",
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(true),
+				},
+			},
+			Default: objectdefault.StaticValue(
+				types.ObjectValueMust(
+					map[string]attr.Type{
+						"data_security_posture_management": types.BoolType,
+						"registry_scanning":                types.BoolType,
+						"registry_scanning_options": types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"type":      types.StringType,
+								"last_days": types.Int32Type,
+							},
+						},
+						"agentless_disk_scanning": types.BoolType,
+						"xsiam_analytics":         types.BoolType,
+						"serverless_scanning":     types.BoolType,
+					},
+					map[string]attr.Value{
+						"data_security_posture_management": types.BoolValue(false),
+						"registry_scanning":                types.BoolValue(true),
+						"registry_scanning_options": types.ObjectValueMust(
+							map[string]attr.Type{
+								"type":      types.StringType,
+								"last_days": types.Int32Type,
+							},
+							map[string]attr.Value{
+								"type":      types.StringValue(enums.RegistryScanningTypeAll.String()),
+								"last_days": types.Int32Null(),
+							},
+						),
+						"agentless_disk_scanning": types.BoolValue(true),
+						"xsiam_analytics":         types.BoolValue(true),
+						"serverless_scanning":     types.BoolValue(true),
+					},
+				),
+			),
+		},
+		"collection_configuration": schema.SingleNestedAttribute{
+			Description:         "Configure log data collection.",
+			MarkdownDescription: "Configure log data collection.",
+			Optional:            true,
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"audit_logs": schema.SingleNestedAttribute{
+					Description:         "Configuration for audit logs collection.",
+					MarkdownDescription: "Configuration for audit logs collection.",
+					Required:            true,
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							Description:         "Whether to enable audit log collection. Default value is \"true\".",
+							MarkdownDescription: "Whether to enable audit log collection. Default value is `true`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"collection_method": schema.StringAttribute{
+							Description:         fmt.Sprintf("Method of audit log collection. Default value is \"%s\".", enums.AuditLogCollectionMethodAutomated.String()),
+							MarkdownDescription: fmt.Sprintf("Method of audit log collection. Default value is `%s`.", enums.AuditLogCollectionMethodAutomated.String()),
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(enums.AuditLogCollectionMethodAutomated.String()),
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									enums.AllAuditLogCollectionMethods()...,
+								),
+							},
+						},
+						"data_events": schema.BoolAttribute{
+							Description: "Whether to collect data " +
+								"events as part of audit log collection. Default value is \"false\".",
+							MarkdownDescription: "Whether to collect data " +
+								"events as part of audit log collection. Default value is `false`.",
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			Default: objectdefault.StaticValue(
+				types.ObjectValueMust(
+					map[string]attr.Type{
+						"audit_logs": types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"enabled":           types.BoolType,
+								"collection_method": types.StringType,
+								"data_events":       types.BoolType,
+							},
+						},
+					},
+					map[string]attr.Value{
+						"audit_logs": types.ObjectValueMust(
+							map[string]attr.Type{
+								"enabled":           types.BoolType,
+								"collection_method": types.StringType,
+								"data_events":       types.BoolType,
+							},
+							map[string]attr.Value{
+								"enabled":           types.BoolValue(true),
+								"collection_method": types.StringValue(enums.AuditLogCollectionMethodAutomated.String()),
+								"data_events":       types.BoolValue(false),
+							},
+						),
+					},
+				),
+			),
+		},
+		"custom_resources_tags": schema.SetNestedAttribute{
+			Description: "Tags applied to any new resource created by " +
+				"Cortex Cloud in the cloud environment." +
+				"\n\nThe tag \"managed_by\" with the value " +
+				"\"paloaltonetworks\" will always be applied by default.",
+			MarkdownDescription: "Tags applied to any new resource created by " +
+				"Cortex Cloud in the cloud environment." +
+				"\n\nThe tag `managed_by` with the value " +
+				"`paloaltonetworks` will be applied by default.",
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Description: "The tag key.",
+						Required:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "The tag value.",
+						Required:    true,
+					},
+				},
+			},
+			//Validators: []validator.Set{
+			//	//setvalidator.
+			//},
+		},
+		"instance_name": schema.StringAttribute{
+			Description:         "The name of the integration template. When the template is executed, integrations will appear in the console with this value.",
+			MarkdownDescription: "The name of the integration template. When the template is executed, integrations will appear in the console with this value.",
+			Optional:            true,
+		},
+		"scan_mode": schema.StringAttribute{
+			Description:         fmt.Sprintf("Define what infrastructure the integration will use to scan cloud workloads. If set to \"MANAGED\", scanning will be done in the Cortex Cloud environment. If set to \"OUTPOST\", scanning will be done on infrastructure deployed to a cloud account owned by you. Possible values are: \"%s\". \n\n~>**NOTE** Scanning with an outpost may require additional CSP permissions and may incur additional costs.", strings.Join(enums.AllScanModes(), "\", \"")),
+			MarkdownDescription: fmt.Sprintf("Define what infrastructure the integration will use to scan cloud workloads. If set to `MANAGED`, scanning will be done in the Cortex Cloud environment. If set to `OUTPOST`, scanning will be done on infrastructure deployed to a cloud account owned by you. Possible values are: `%s`. \n\n~>**NOTE** Scanning with an outpost may require additional CSP permissions and may incur additional costs.", strings.Join(enums.AllScanModes(), "`, `")),
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					enums.AllScanModes()...,
+				),
+				validators.AlsoRequiresOnStringValues(
+					[]string{
+						enums.ScanModeOutpost.String(),
+					},
+					path.MatchRelative().AtParent().AtName("outpost_id"),
+				),
+			},
+		},
+		"scope": schema.StringAttribute{
+			Description:         fmt.Sprintf("Define the scope for this integration instance. Possible values are: \"%s\"", strings.Join(enums.AllScopes(), "\", \"")),
+			MarkdownDescription: fmt.Sprintf("Define the scope for this integration instance. Possible values are: `%s`", strings.Join(enums.AllScopes(), "`, `")),
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					enums.AllScopes()...,
+				),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"outpost_id": schema.StringAttribute{
+			Description:         fmt.Sprintf("The ID of the deployed outpost that will be used for scanning. \n\nMust be configured if \"scan_mode\" attribute is set to \"OUTPOST\". If \"scan_mode\" is set to \"MANAGED\", this will be set to the ID of the platform's internal %s outpost.", cloudName),
+			MarkdownDescription: fmt.Sprintf("The ID of the deployed outpost that will be used for scanning. \n\nMust be configured if `scan_mode` attribute is set to `OUTPOST`. If `scan_mode` is set to `MANAGED`, this will be set to the ID of the platform's internal %s outpost.", cloudName),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"destroy_action": destroyActionAttribute(),
+		"status": schema.StringAttribute{
+			Description:         "Status of the template.",
+			MarkdownDescription: "Status of the template.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"tracking_guid": schema.StringAttribute{
+			Description:         "The unique ID value assigned to this template after creation.",
+			MarkdownDescription: "The unique ID value assigned to this template after creation.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// scopeModificationsAttribute returns the scope_modifications attribute of a
+// cloud integration template resource. The entity is the cloud-specific
+// equivalent of an AWS account (e.g. "subscription" for Azure), which is used
+// to name the entity-level scope modification block and its IDs attribute.
+func scopeModificationsAttribute(entity, cloudName string) schema.SingleNestedAttribute {
+	ids := entity + "_ids"
+
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("Define the scope of scans by including/excluding "+
+			"%ss or regions.", entity),
+		MarkdownDescription: fmt.Sprintf("Define the scope of scans by including/excluding "+
+			"%ss or regions.", entity),
+		Optional: true,
+		Computed: true,
+		Attributes: map[string]schema.Attribute{
+			entity + "s": schema.SingleNestedAttribute{
+				Description: fmt.Sprintf("Configuration for %s-level scope "+
+					"modifications for %s integrations.", entity, cloudName),
+				MarkdownDescription: fmt.Sprintf("Configuration for %s-level scope "+
+					"modifications for %s integrations.", entity, cloudName),
+				Optional: true,
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description:         fmt.Sprintf("Whether to enable this scope modification. Cannot be set to \"true\" if scope is set to \"ACCOUNT\". If enabled, the \"type\" and \"%s\" attributes must be configured as well.", ids),
+						MarkdownDescription: fmt.Sprintf("Whether to enable this scope modification. Cannot be set to `true` if scope is set to `ACCOUNT`. If enabled, the `type` and `%s` attributes must be configured as well.", ids),
+						Required:            true,
+						Validators: []validator.Bool{
+							validators.AlsoRequiresOnBoolValue(
+								true,
+								path.MatchRelative().AtParent().AtName("type"),
+							),
+							validators.AlsoRequiresOnBoolValue(
+								true,
+								path.MatchRelative().AtParent().AtName(ids),
+							),
+						},
+					},
+					"type": schema.StringAttribute{
+						Description:         fmt.Sprintf("Whether the specified %s IDs should be included in the scope or excluded from the scope. Must be configured if \"enabled\" is set to \"true\" and scope is not set to `ACCOUNT`. Possible values are: \"%s\"", entity, strings.Join(enums.AllScopeModificationTypes(), "\", \"")),
+						MarkdownDescription: fmt.Sprintf("Whether the specified %s IDs should be included in the scope or excluded from the scope. Must be configured if `enabled` is set to `true` and scope is not set to `ACCOUNT`. Possible values are: `%s`", entity, strings.Join(enums.AllScopeModificationTypes(), "`, `")),
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								enums.AllScopeModificationTypes()...,
+							),
+						},
+					},
+					ids: schema.SetAttribute{
+						Description:         strings.ToUpper(entity[:1]) + entity[1:] + " IDs to include or exclude from scans. Cannot be configured if scope is set to \"ACCOUNT\". If scope is set to \"ORGANIZATION\" or \"ACCOUNT_GROUP\" and enabled is set to \"true\", it must be configured with at least 1 value.",
+						MarkdownDescription: strings.ToUpper(entity[:1]) + entity[1:] + " IDs to include or exclude from scans. Cannot be configured if scope is set to `ACCOUNT`. If scope is set to `ORGANIZATION` or `ACCOUNT_GROUP` and enabled is set to `true`, it must be configured with at least 1 value.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+				Default: objectdefault.StaticValue(
+					types.ObjectNull(
+						map[string]attr.Type{
+							"enabled": types.BoolType,
+							"type":    types.StringType,
+							ids: types.SetType{
+								ElemType: types.StringType,
+							},
+						},
+					),
+				),
+			},
+			"regions": schema.SingleNestedAttribute{
+				Description:         "Configuration for regional scope modifications.",
+				MarkdownDescription: "Configuration for regional scope modifications.",
+				Optional:            true,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description:         "Whether to enable this scope modification. If set to \"true\", the \"type\" and \"regions\" attributes must be configured as well.",
+						MarkdownDescription: "Whether to enable this scope modification. If set to `true`, the `type` and `regions` attributes must be configured as well.",
+						Required:            true,
+						Validators: []validator.Bool{
+							validators.AlsoRequiresOnBoolValue(
+								true,
+								path.MatchRelative().AtParent().AtName("type"),
+							),
+							validators.AlsoRequiresOnBoolValue(
+								true,
+								path.MatchRelative().AtParent().AtName("regions"),
+							),
+						},
+					},
+					"type": schema.StringAttribute{
+						Description:         fmt.Sprintf("Whether the specified regions should be included in the scope or excluded from the scope. Must be configured if \"enabled\" is set to \"true\". Possible values are: \"%s\"", strings.Join(enums.AllScopeModificationTypes(), "\", \"")),
+						MarkdownDescription: fmt.Sprintf("Whether the specified regions should be included in the scope or excluded from the scope. Must be configured if `enabled` is set to `true`. Possible values are: `%s`", strings.Join(enums.AllScopeModificationTypes(), "`, `")),
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								enums.AllScopeModificationTypes()...,
+							),
+						},
+					},
+					"regions": schema.SetAttribute{
+						Description:         "Regions to include or exclude from scans. Must be configured with at least 1 value if \"enabled\" is set to \"true\".",
+						MarkdownDescription: "Regions to include or exclude from scans. Must be configured with at least 1 value if `enabled` is set to `true`.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+				Default: objectdefault.StaticValue(
+					types.ObjectValueMust(
+						map[string]attr.Type{
+							"enabled": types.BoolType,
+							"type":    types.StringType,
+							"regions": types.SetType{
+								ElemType: types.StringType,
+							},
+						},
+						map[string]attr.Value{
+							"enabled": types.BoolValue(false),
+							"type":    types.StringNull(),
+							"regions": types.SetNull(types.StringType),
+						},
+					),
+				),
+			},
+		},
+		Default: objectdefault.StaticValue(
+			types.ObjectValueMust(
+				map[string]attr.Type{
+					entity + "s": types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"enabled": types.BoolType,
+							"type":    types.StringType,
+							ids: types.SetType{
+								ElemType: types.StringType,
+							},
+						},
+					},
+					"regions": types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"enabled": types.BoolType,
+							"type":    types.StringType,
+							"regions": types.SetType{
+								ElemType: types.StringType,
+							},
+						},
+					},
+				},
+				map[string]attr.Value{
+					entity + "s": types.ObjectNull(
+						map[string]attr.Type{
+							"enabled": types.BoolType,
+							"type":    types.StringType,
+							ids: types.SetType{
+								ElemType: types.StringType,
+							},
+						},
+					),
+					"regions": types.ObjectValueMust(
+						map[string]attr.Type{
+							"enabled": types.BoolType,
+							"type":    types.StringType,
+							"regions": types.SetType{
+								ElemType: types.StringType,
+							},
+						},
+						map[string]attr.Value{
+							"enabled": types.BoolValue(false),
+							"type":    types.StringNull(),
+							"regions": types.SetNull(types.StringType),
+						},
+					),
+				},
+			),
+		),
+	}
+}
@@ -131,7 +131,7 @@ func (r *OutpostTemplateResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 
-	r.client = client.CloudOnboarding
+	r.client = client.CloudOnboarding.Client(ctx, &resp.Diagnostics)
 }
 
 func (r *OutpostTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -172,6 +172,7 @@ func (r *OutpostTemplateResource) ModifyPlan(ctx context.Context, req resource.M
 // Create creates the resource and sets the initial Terraform state.
 func (r *OutpostTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan models.OutpostTemplateModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
@@ -220,6 +221,7 @@ func (r *OutpostTemplateResource) Read(ctx context.Context, req resource.ReadReq
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *OutpostTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	ctx = tflog.SetField(ctx, "resource_type", "outpost_template")
 	ctx = tflog.SetField(ctx, "resource_operation", "Update")
@@ -233,6 +235,7 @@ func (r *OutpostTemplateResource) Update(ctx context.Context, req resource.Updat
 // Delete deletes the resource and removes it from the Terraform state on success.
 func (r *OutpostTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 	resp.State.RemoveResource(ctx)
 }
 
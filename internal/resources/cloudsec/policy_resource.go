@@ -35,6 +35,7 @@ var (
 	_ resource.Resource                   = &CloudSecPolicyResource{}
 	_ resource.ResourceWithImportState    = &CloudSecPolicyResource{}
 	_ resource.ResourceWithValidateConfig = &CloudSecPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &CloudSecPolicyResource{}
 )
 
 // NewCloudSecPolicyResource is a helper function to simplify the provider implementation.
@@ -44,7 +45,9 @@ func NewCloudSecPolicyResource() resource.Resource {
 
 // CloudSecPolicyResource is the resource implementation.
 type CloudSecPolicyResource struct {
-	client *cloudsec.Client
+	client          *cloudsec.Client
+	defaultLabels   []string
+	externalChanges util.ExternalChangeDetection
 }
 
 // Metadata returns the resource type name.
@@ -118,6 +121,11 @@ func (r *CloudSecPolicyResource) Schema(ctx context.Context, req resource.Schema
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"effective_labels": schema.SetAttribute{
+				Description: "All labels assigned to the policy, including the labels added by the provider's `default_labels` attribute.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"rule_matching": schema.SingleNestedAttribute{
 				Description: "Configuration for how rules are matched to this policy.",
 				Required:    true,
@@ -212,12 +220,21 @@ func (r *CloudSecPolicyResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	r.client = clients.CloudSec
+	r.client = clients.CloudSec.Client(ctx, &resp.Diagnostics)
+	r.defaultLabels = clients.DefaultLabels
+	r.externalChanges = clients.ExternalChanges
+}
+
+// ModifyPlan sets the planned `effective_labels` to the union of the
+// configured labels and the provider's default labels.
+func (r *CloudSecPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	util.ModifyPlanLabels[types.Set](ctx, req, resp, r.defaultLabels)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *CloudSecPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan cloudsecModels.CloudSecPolicyResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -235,6 +252,9 @@ func (r *CloudSecPolicyResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	// Include the provider's default labels in the request
+	plan.EffectiveLabels = util.EffectiveLabels(ctx, plan.Labels, r.defaultLabels, &resp.Diagnostics)
+
 	// Convert plan to SDK create request
 	createReq := plan.ToSDKCreateRequest(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -280,10 +300,12 @@ func (r *CloudSecPolicyResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Update plan with response data
+	configuredLabels := plan.Labels
 	plan.FromSDKResponse(ctx, &resp.Diagnostics, &createResp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.Labels = util.SuppressDefaultLabels(ctx, plan.Labels, configuredLabels, r.defaultLabels, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -291,6 +313,7 @@ func (r *CloudSecPolicyResource) Create(ctx context.Context, req resource.Create
 // Read refreshes the Terraform state with the latest data.
 func (r *CloudSecPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state cloudsecModels.CloudSecPolicyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -319,10 +342,16 @@ func (r *CloudSecPolicyResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Update state with response data
+	priorModifiedBy, priorModifiedAt := state.UpdatedBy, state.UpdatedAt
+	configuredLabels := state.Labels
 	state.FromSDKResponse(ctx, &resp.Diagnostics, &policyResp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.Labels = util.SuppressDefaultLabels(ctx, state.Labels, configuredLabels, r.defaultLabels, &resp.Diagnostics)
+
+	// Warn if the resource was modified outside of Terraform
+	r.externalChanges.Check(&resp.Diagnostics, "cortexcloud_cloudsec_policy", state.ID.ValueString(), state.UpdatedBy, state.UpdatedAt, priorModifiedBy, priorModifiedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -330,6 +359,7 @@ func (r *CloudSecPolicyResource) Read(ctx context.Context, req resource.ReadRequ
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *CloudSecPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state cloudsecModels.CloudSecPolicyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -353,6 +383,9 @@ func (r *CloudSecPolicyResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	// Include the provider's default labels in the request
+	plan.EffectiveLabels = util.EffectiveLabels(ctx, plan.Labels, r.defaultLabels, &resp.Diagnostics)
+
 	// Convert plan to SDK update request
 	updateReq := plan.ToSDKUpdateRequest(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -370,10 +403,12 @@ func (r *CloudSecPolicyResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update plan with response data
+	configuredLabels := plan.Labels
 	plan.FromSDKResponse(ctx, &resp.Diagnostics, &policyResp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.Labels = util.SuppressDefaultLabels(ctx, plan.Labels, configuredLabels, r.defaultLabels, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -381,6 +416,7 @@ func (r *CloudSecPolicyResource) Update(ctx context.Context, req resource.Update
 // Delete deletes the resource and removes it from the Terraform state on success.
 func (r *CloudSecPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state cloudsecModels.CloudSecPolicyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
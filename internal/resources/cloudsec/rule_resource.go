@@ -38,6 +38,7 @@ import (
 var (
 	_ resource.Resource                = &CloudSecRuleResource{}
 	_ resource.ResourceWithImportState = &CloudSecRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &CloudSecRuleResource{}
 )
 
 // NewCloudSecRuleResource is a helper function to simplify the provider implementation.
@@ -49,6 +50,8 @@ func NewCloudSecRuleResource() resource.Resource {
 type CloudSecRuleResource struct {
 	client           *cloudsec.Client
 	complianceClient *compliance.Client
+	defaultLabels    []string
+	externalChanges  util.ExternalChangeDetection
 }
 
 // Metadata returns the resource type name.
@@ -197,6 +200,11 @@ func (r *CloudSecRuleResource) Schema(ctx context.Context, req resource.SchemaRe
 					setvalidator.ValueStringsAre(stringvalidator.LengthAtMost(100)),
 				},
 			},
+			"effective_labels": schema.SetAttribute{
+				Description: "All labels assigned to the rule, including the labels added by the provider's `default_labels` attribute.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"enabled": schema.BoolAttribute{
 				Description: "Whether the rule is enabled (defaults to true).",
 				Optional:    true,
@@ -262,13 +270,22 @@ func (r *CloudSecRuleResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	r.client = clients.CloudSec
-	r.complianceClient = clients.Compliance
+	r.client = clients.CloudSec.Client(ctx, &resp.Diagnostics)
+	r.complianceClient = clients.Compliance.Client(ctx, &resp.Diagnostics)
+	r.defaultLabels = clients.DefaultLabels
+	r.externalChanges = clients.ExternalChanges
+}
+
+// ModifyPlan sets the planned `effective_labels` to the union of the
+// configured labels and the provider's default labels.
+func (r *CloudSecRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	util.ModifyPlanLabels[types.Set](ctx, req, resp, r.defaultLabels)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *CloudSecRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan cloudsecModels.CloudSecRuleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -276,6 +293,9 @@ func (r *CloudSecRuleResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	// Include the provider's default labels in the request
+	plan.EffectiveLabels = util.EffectiveLabels(ctx, plan.Labels, r.defaultLabels, &resp.Diagnostics)
+
 	// Convert plan to SDK create request
 	createReq := plan.ToSDKCreateRequest(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -299,10 +319,12 @@ func (r *CloudSecRuleResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Update plan with response data
+	configuredLabels := plan.Labels
 	plan.FromSDKResponse(ctx, &resp.Diagnostics, &ruleResp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.Labels = util.SuppressDefaultLabels(ctx, plan.Labels, configuredLabels, r.defaultLabels, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -310,6 +332,7 @@ func (r *CloudSecRuleResource) Create(ctx context.Context, req resource.CreateRe
 // Read refreshes the Terraform state with the latest data.
 func (r *CloudSecRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state cloudsecModels.CloudSecRuleResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -338,10 +361,16 @@ func (r *CloudSecRuleResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Update state with response data
+	priorModifiedBy, priorModifiedAt := state.LastModifiedBy, state.LastModifiedOn
+	configuredLabels := state.Labels
 	state.FromSDKResponse(ctx, &resp.Diagnostics, &ruleResp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.Labels = util.SuppressDefaultLabels(ctx, state.Labels, configuredLabels, r.defaultLabels, &resp.Diagnostics)
+
+	// Warn if the resource was modified outside of Terraform
+	r.externalChanges.Check(&resp.Diagnostics, "cortexcloud_cloudsec_rule", state.ID.ValueString(), state.LastModifiedBy, state.LastModifiedOn, priorModifiedBy, priorModifiedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -349,6 +378,7 @@ func (r *CloudSecRuleResource) Read(ctx context.Context, req resource.ReadReques
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *CloudSecRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state cloudsecModels.CloudSecRuleResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -362,6 +392,9 @@ func (r *CloudSecRuleResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	// Include the provider's default labels in the request
+	plan.EffectiveLabels = util.EffectiveLabels(ctx, plan.Labels, r.defaultLabels, &resp.Diagnostics)
+
 	// Convert plan to SDK update request (selective-PATCH: only changed fields)
 	updateReq := plan.ToSDKUpdateRequest(ctx, &resp.Diagnostics, &state)
 	if resp.Diagnostics.HasError() {
@@ -395,10 +428,12 @@ func (r *CloudSecRuleResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Update plan with the complete response data from GET
+	configuredLabels := plan.Labels
 	plan.FromSDKResponse(ctx, &resp.Diagnostics, &ruleResp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.Labels = util.SuppressDefaultLabels(ctx, plan.Labels, configuredLabels, r.defaultLabels, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -406,6 +441,7 @@ func (r *CloudSecRuleResource) Update(ctx context.Context, req resource.UpdateRe
 // Delete deletes the resource and removes it from the Terraform state on success.
 func (r *CloudSecRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state cloudsecModels.CloudSecRuleResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -36,7 +36,8 @@ func NewAssessmentProfileResource() resource.Resource {
 
 // assessmentProfileResource is the resource implementation.
 type assessmentProfileResource struct {
-	client *compliance.Client
+	client          *compliance.Client
+	externalChanges util.ExternalChangeDetection
 }
 
 // Metadata returns the resource type name.
@@ -137,12 +138,14 @@ func (r *assessmentProfileResource) Configure(ctx context.Context, req resource.
 		return
 	}
 
-	r.client = client.Compliance
+	r.client = client.Compliance.Client(ctx, &resp.Diagnostics)
+	r.externalChanges = client.ExternalChanges
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *assessmentProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan complianceModels.AssessmentProfileModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -238,6 +241,7 @@ func (r *assessmentProfileResource) Create(ctx context.Context, req resource.Cre
 // Read refreshes the Terraform state with the latest data.
 func (r *assessmentProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state complianceModels.AssessmentProfileModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -259,11 +263,15 @@ func (r *assessmentProfileResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Update state with remote data
+	priorModifiedBy, priorModifiedAt := state.ModifiedBy, state.ModifyTS
 	state.RefreshFromRemote(ctx, &resp.Diagnostics, remote)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Warn if the resource was modified outside of Terraform
+	r.externalChanges.Check(&resp.Diagnostics, "cortexcloud_compliance_assessment_profile", state.ID.ValueString(), state.ModifiedBy, state.ModifyTS, priorModifiedBy, priorModifiedAt)
+
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -271,6 +279,7 @@ func (r *assessmentProfileResource) Read(ctx context.Context, req resource.ReadR
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *assessmentProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan complianceModels.AssessmentProfileModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -319,6 +328,7 @@ func (r *assessmentProfileResource) Update(ctx context.Context, req resource.Upd
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *assessmentProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state complianceModels.AssessmentProfileModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
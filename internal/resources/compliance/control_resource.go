@@ -38,7 +38,8 @@ func NewControlResource() resource.Resource {
 
 // controlResource is the resource implementation.
 type controlResource struct {
-	client *compliance.Client
+	client          *compliance.Client
+	externalChanges util.ExternalChangeDetection
 }
 
 // Metadata returns the resource type name.
@@ -149,12 +150,14 @@ func (r *controlResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	r.client = client.Compliance
+	r.client = client.Compliance.Client(ctx, &resp.Diagnostics)
+	r.externalChanges = client.ExternalChanges
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *controlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan complianceModels.ControlModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -203,6 +206,7 @@ func (r *controlResource) Create(ctx context.Context, req resource.CreateRequest
 // Read refreshes the Terraform state with the latest data.
 func (r *controlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state complianceModels.ControlModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -224,11 +228,15 @@ func (r *controlResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Update state with remote data
+	priorModifiedBy, priorModifiedAt := state.ModifiedBy, state.ModificationTime
 	state.RefreshFromRemote(ctx, &resp.Diagnostics, remote)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Warn if the resource was modified outside of Terraform
+	r.externalChanges.Check(&resp.Diagnostics, "cortexcloud_compliance_control", state.ID.ValueString(), state.ModifiedBy, state.ModificationTime, priorModifiedBy, priorModifiedAt)
+
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -236,6 +244,7 @@ func (r *controlResource) Read(ctx context.Context, req resource.ReadRequest, re
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *controlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan complianceModels.ControlModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -299,6 +308,7 @@ func (r *controlResource) Update(ctx context.Context, req resource.UpdateRequest
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *controlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state complianceModels.ControlModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -27,6 +27,7 @@ var (
 	_ resource.Resource                = &standardResource{}
 	_ resource.ResourceWithConfigure   = &standardResource{}
 	_ resource.ResourceWithImportState = &standardResource{}
+	_ resource.ResourceWithModifyPlan  = &standardResource{}
 )
 
 // NewStandardResource is a helper function to simplify the provider implementation.
@@ -36,7 +37,8 @@ func NewStandardResource() resource.Resource {
 
 // standardResource is the resource implementation.
 type standardResource struct {
-	client *compliance.Client
+	client        *compliance.Client
+	defaultLabels []string
 }
 
 // Metadata returns the resource type name.
@@ -82,6 +84,11 @@ func (r *standardResource) Schema(ctx context.Context, req resource.SchemaReques
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"effective_labels": schema.SetAttribute{
+				Description: "All labels assigned to the standard, including the labels added by the provider's `default_labels` attribute.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"revision": schema.Int64Attribute{
 				Description: "The revision number of the standard. This value increments on every update.",
 				Computed:    true,
@@ -146,12 +153,20 @@ func (r *standardResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	r.client = client.Compliance
+	r.client = client.Compliance.Client(ctx, &resp.Diagnostics)
+	r.defaultLabels = client.DefaultLabels
+}
+
+// ModifyPlan sets the planned `effective_labels` to the union of the
+// configured labels and the provider's default labels.
+func (r *standardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	util.ModifyPlanLabels[types.Set](ctx, req, resp, r.defaultLabels)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *standardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan complianceModels.StandardModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -159,6 +174,9 @@ func (r *standardResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// Include the provider's default labels in the request
+	plan.EffectiveLabels = util.EffectiveLabels(ctx, plan.Labels, r.defaultLabels, &resp.Diagnostics)
+
 	// Convert plan to create request
 	createReq := plan.ToCreateRequest(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -202,10 +220,12 @@ func (r *standardResource) Create(ctx context.Context, req resource.CreateReques
 	remote := &listResp.Standards[0]
 
 	// Update plan with remote data
+	configuredLabels := plan.Labels
 	plan.RefreshFromRemote(ctx, &resp.Diagnostics, remote)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.Labels = util.SuppressDefaultLabels(ctx, plan.Labels, configuredLabels, r.defaultLabels, &resp.Diagnostics)
 
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -214,6 +234,7 @@ func (r *standardResource) Create(ctx context.Context, req resource.CreateReques
 // Read refreshes the Terraform state with the latest data.
 func (r *standardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state complianceModels.StandardModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -235,10 +256,12 @@ func (r *standardResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Update state with remote data
+	configuredLabels := state.Labels
 	state.RefreshFromRemote(ctx, &resp.Diagnostics, remote)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.Labels = util.SuppressDefaultLabels(ctx, state.Labels, configuredLabels, r.defaultLabels, &resp.Diagnostics)
 
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -247,6 +270,7 @@ func (r *standardResource) Read(ctx context.Context, req resource.ReadRequest, r
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *standardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan complianceModels.StandardModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -254,6 +278,9 @@ func (r *standardResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// Include the provider's default labels in the request
+	plan.EffectiveLabels = util.EffectiveLabels(ctx, plan.Labels, r.defaultLabels, &resp.Diagnostics)
+
 	// Convert plan to update request
 	updateReq := plan.ToUpdateRequest(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -283,10 +310,12 @@ func (r *standardResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Update plan with remote data
+	configuredLabels := plan.Labels
 	plan.RefreshFromRemote(ctx, &resp.Diagnostics, remote)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.Labels = util.SuppressDefaultLabels(ctx, plan.Labels, configuredLabels, r.defaultLabels, &resp.Diagnostics)
 
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -295,6 +324,7 @@ func (r *standardResource) Update(ctx context.Context, req resource.UpdateReques
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *standardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state complianceModels.StandardModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
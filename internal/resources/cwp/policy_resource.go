@@ -152,16 +152,19 @@ func (r *policyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"condition": schema.StringAttribute{
 				Description: "The condition in blob form (base64 encoded). Required for non-compliance policies.",
+				CustomType:  util.NormalizedJSONType{},
 				Optional:    true,
 				Computed:    true,
 			},
 			"exception": schema.StringAttribute{
 				Description: "The exception in blob form (base64 encoded).",
+				CustomType:  util.NormalizedJSONType{},
 				Optional:    true,
 				Computed:    true,
 			},
 			"asset_scope": schema.StringAttribute{
 				Description: "The asset scope in blob form (base64 encoded).",
+				CustomType:  util.NormalizedJSONType{},
 				Optional:    true,
 				Computed:    true,
 			},
@@ -205,12 +208,13 @@ func (r *policyResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	r.client = client.CWP
+	r.client = client.CWP.Client(ctx, &resp.Diagnostics)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan cwpModels.PolicyModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -251,6 +255,7 @@ func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest,
 // Read refreshes the Terraform state with the latest data.
 func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state cwpModels.PolicyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -280,6 +285,7 @@ func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, res
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan cwpModels.PolicyModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -320,6 +326,7 @@ func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest,
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state cwpModels.PolicyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
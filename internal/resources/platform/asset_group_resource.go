@@ -26,7 +26,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &AssetGroupResource{}
+	_ resource.Resource                = &AssetGroupResource{}
+	_ resource.ResourceWithImportState = &AssetGroupResource{}
 )
 
 // NewAssetGroupResource is a helper function to simplify the provider implementation.
@@ -36,7 +37,8 @@ func NewAssetGroupResource() resource.Resource {
 
 // AssetGroupResource is the resource implementation.
 type AssetGroupResource struct {
-	client *platform.Client
+	client          *platform.Client
+	externalChanges util.ExternalChangeDetection
 }
 
 // Metadata returns the resource type name.
@@ -114,7 +116,8 @@ func (r *AssetGroupResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	r.client = client.Platform
+	r.client = client.Platform.Client(ctx, &resp.Diagnostics)
+	r.externalChanges = client.ExternalChanges
 }
 
 func (r *AssetGroupResource) findAssetGroup(ctx context.Context, id int) (*platformTypes.AssetGroup, error) {
@@ -140,6 +143,7 @@ func (r *AssetGroupResource) findAssetGroup(ctx context.Context, id int) (*platf
 // Create creates the resource and sets the initial Terraform state.
 func (r *AssetGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan platformModels.AssetGroupModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -191,6 +195,7 @@ func (r *AssetGroupResource) Create(ctx context.Context, req resource.CreateRequ
 // Read refreshes the Terraform state with the latest data.
 func (r *AssetGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state platformModels.AssetGroupModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -210,17 +215,22 @@ func (r *AssetGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	priorModifiedBy, priorModifiedAt := state.ModifiedBy, state.LastUpdateTime
 	state.RefreshFromRemote(ctx, &resp.Diagnostics, assetGroup)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Warn if the resource was modified outside of Terraform
+	r.externalChanges.Check(&resp.Diagnostics, "cortexcloud_asset_group", strconv.FormatInt(state.ID.ValueInt64(), 10), state.ModifiedBy, state.LastUpdateTime, priorModifiedBy, priorModifiedAt)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *AssetGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state platformModels.AssetGroupModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -278,6 +288,7 @@ func (r *AssetGroupResource) Update(ctx context.Context, req resource.UpdateRequ
 // Delete deletes the resource and removes it from the Terraform state on success.
 func (r *AssetGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state platformModels.AssetGroupModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -295,3 +306,37 @@ func (r *AssetGroupResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 }
+
+// ImportState imports an existing asset group by its numeric ID.
+func (r *AssetGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected the numeric ID of an asset group, got \"%s\".", req.ID),
+		)
+		return
+	}
+
+	assetGroup, err := r.findAssetGroup(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Asset Group", err.Error())
+		return
+	}
+
+	if assetGroup == nil {
+		resp.Diagnostics.AddError("Error Importing Asset Group", fmt.Sprintf("No asset group found with ID %d.", id))
+		return
+	}
+
+	var state platformModels.AssetGroupModel
+	state.RefreshFromRemote(ctx, &resp.Diagnostics, assetGroup)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
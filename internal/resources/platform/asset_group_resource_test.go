@@ -0,0 +1,172 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// newAssetGroupListServer returns a mock of the asset group list endpoint
+// that responds with the given asset groups, along with a function returning
+// the bodies of the requests it received.
+func newAssetGroupListServer(t *testing.T, assetGroups ...string) (func() []string, *httptest.Server) {
+	t.Helper()
+
+	var (
+		mu            sync.Mutex
+		requestBodies []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		for strings.Contains(path, "//") {
+			path = strings.ReplaceAll(path, "//", "/")
+		}
+		if strings.HasSuffix(path, "/") && path != "/" {
+			path = strings.TrimSuffix(path, "/")
+		}
+
+		switch {
+		case strings.Contains(path, "asset-groups") && r.Method == http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			requestBodies = append(requestBodies, string(body))
+			mu.Unlock()
+
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{
+				"reply": {
+					"data": [%s],
+					"filter_count": %d,
+					"total_count": %d
+				}
+			}`, strings.Join(assetGroups, ","), len(assetGroups), len(assetGroups))
+		default:
+			http.Error(w, fmt.Sprintf("[%s] Endpoint not found: %s %s", t.Name(), r.Method, path), http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]string{}, requestBodies...)
+	}, server
+}
+
+func testAssetGroupResourceConfig(serverURL string) string {
+	return fmt.Sprintf(`
+		provider "cortexcloud" {
+			api_url    = "%s"
+			api_key    = "test"
+			api_key_id = 123
+		}
+
+		resource "cortexcloud_asset_group" "test" {
+			name        = "Production"
+			type        = "Dynamic"
+			description = "Production assets"
+		}
+	`, serverURL)
+}
+
+func TestUnitAssetGroupResource_Import(t *testing.T) {
+	requestBodies, server := newAssetGroupListServer(t, `
+		{
+			"XDM.ASSET_GROUP.ID": 10,
+			"XDM.ASSET_GROUP.NAME": "Production",
+			"XDM.ASSET_GROUP.TYPE": "Dynamic",
+			"XDM.ASSET_GROUP.DESCRIPTION": "Production assets",
+			"XDM.ASSET_GROUP.CREATION_TIME": 1678886400000,
+			"XDM.ASSET_GROUP.CREATED_BY": "test-user",
+			"XDM.ASSET_GROUP.LAST_UPDATE_TIME": 1678972800000,
+			"XDM.ASSET_GROUP.MODIFIED_BY": "other-user"
+		}`)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:        testAssetGroupResourceConfig(server.URL),
+				ResourceName:  "cortexcloud_asset_group.test",
+				ImportState:   true,
+				ImportStateId: "10",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+					}
+
+					expected := map[string]string{
+						"id":               "10",
+						"name":             "Production",
+						"type":             "Dynamic",
+						"description":      "Production assets",
+						"creation_time":    "1678886400000",
+						"created_by":       "test-user",
+						"last_update_time": "1678972800000",
+						"modified_by":      "other-user",
+					}
+					for name, value := range expected {
+						if actual := states[0].Attributes[name]; actual != value {
+							return fmt.Errorf("expected %s to be %q, got %q", name, value, actual)
+						}
+					}
+
+					// The asset group must be looked up by its ID
+					for _, body := range requestBodies() {
+						if !strings.Contains(body, "XDM.ASSET_GROUP.ID") {
+							return fmt.Errorf("expected the request to filter on XDM.ASSET_GROUP.ID, got %s", body)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestUnitAssetGroupResource_ImportInvalidID(t *testing.T) {
+	_, server := newAssetGroupListServer(t)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// The import ID must be numeric
+			{
+				Config:        testAssetGroupResourceConfig(server.URL),
+				ResourceName:  "cortexcloud_asset_group.test",
+				ImportState:   true,
+				ImportStateId: "Production",
+				ExpectError:   regexp.MustCompile(`Expected the numeric ID of an asset group`),
+			},
+			// The asset group must exist
+			{
+				Config:        testAssetGroupResourceConfig(server.URL),
+				ResourceName:  "cortexcloud_asset_group.test",
+				ImportState:   true,
+				ImportStateId: "10",
+				ExpectError:   regexp.MustCompile(`No asset group found with ID 10`),
+			},
+		},
+	})
+}
@@ -15,6 +15,7 @@ import (
 	platformTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/platform"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -27,7 +28,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &AuthenticationSettingsResource{}
+	_ resource.Resource                = &AuthenticationSettingsResource{}
+	_ resource.ResourceWithImportState = &AuthenticationSettingsResource{}
 )
 
 // NewAuthenticationSettingsResource is a helper function to simplify the provider implementation.
@@ -252,7 +254,7 @@ func (r *AuthenticationSettingsResource) Configure(ctx context.Context, req reso
 		return
 	}
 
-	r.client = client.Platform
+	r.client = client.Platform.Client(ctx, &resp.Diagnostics)
 }
 
 func (r *AuthenticationSettingsResource) findAuthSettings(ctx context.Context, name, domain string) (*platformTypes.AuthSettings, error) {
@@ -307,6 +309,7 @@ func (r *AuthenticationSettingsResource) refreshModelFromAPI(authSettings *platf
 // Create creates the resource and sets the initial Terraform state.
 func (r *AuthenticationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	// Read Terraform plan data into model
 	var plan models.AuthenticationSettingsModel
@@ -381,6 +384,7 @@ func (r *AuthenticationSettingsResource) Create(ctx context.Context, req resourc
 // Read refreshes the Terraform state with the latest data.
 func (r *AuthenticationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	// Get current state
 	var state models.AuthenticationSettingsModel
@@ -418,6 +422,7 @@ func (r *AuthenticationSettingsResource) Read(ctx context.Context, req resource.
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *AuthenticationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	// Read Terraform plan data into model
 	var plan models.AuthenticationSettingsModel
@@ -500,6 +505,7 @@ func (r *AuthenticationSettingsResource) Update(ctx context.Context, req resourc
 // Delete deletes the resource and removes it from the Terraform state on success.
 func (r *AuthenticationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	// Get current state
 	var state models.AuthenticationSettingsModel
@@ -518,3 +524,59 @@ func (r *AuthenticationSettingsResource) Delete(ctx context.Context, req resourc
 		return
 	}
 }
+
+// ImportState imports existing authentication settings by the name or the
+// domain of the SSO integration.
+func (r *AuthenticationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	allAuthSettings, err := r.client.ListAuthSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Authentication Settings",
+			err.Error(),
+		)
+		return
+	}
+
+	var matches []platformTypes.AuthSettings
+	for _, as := range allAuthSettings {
+		if as.Name == req.ID || (as.Domain != "" && as.Domain == req.ID) {
+			matches = append(matches, as)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Importing Authentication Settings",
+			fmt.Sprintf(`No authentication settings found with name or domain "%s".`, req.ID),
+		)
+		return
+	}
+
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Error Importing Authentication Settings",
+			fmt.Sprintf(`Multiple authentication settings found with name or domain "%s". Import using a value that uniquely identifies the SSO integration.`, req.ID),
+		)
+		return
+	}
+
+	// The state is null when importing, so the identifier is set first to
+	// allow the remaining attributes to be retrieved as typed null values.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), matches[0].Name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.AuthenticationSettingsModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshModelFromAPI(&matches[0], &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -99,6 +99,13 @@ func TestUnitAuthenticationSettingsResource(t *testing.T) {
 					resource.TestCheckResourceAttr("cortexcloud_authentication_settings.test", "domain", "test.domain"),
 				),
 			},
+			{
+				ResourceName:                         "cortexcloud_authentication_settings.test",
+				ImportState:                          true,
+				ImportStateId:                        "test.domain",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
 		},
 	})
 }
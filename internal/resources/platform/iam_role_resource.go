@@ -5,6 +5,7 @@ package platform
 
 import (
 	"context"
+	"fmt"
 
 	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/platform"
 	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/PaloAltoNetworks/cortex-cloud-go/platform"
 	platformTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/platform"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,7 +23,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &iamRoleResource{}
+	_ resource.Resource                = &iamRoleResource{}
+	_ resource.ResourceWithImportState = &iamRoleResource{}
 )
 
 // NewIamRoleResource is a helper function to simplify the provider implementation.
@@ -118,12 +121,13 @@ func (r *iamRoleResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	r.client = client.Platform
+	r.client = client.Platform.Client(ctx, &resp.Diagnostics)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *iamRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan models.IamRoleModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -195,6 +199,7 @@ func (r *iamRoleResource) Create(ctx context.Context, req resource.CreateRequest
 // Read refreshes the Terraform state with the latest data.
 func (r *iamRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state models.IamRoleModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -233,6 +238,7 @@ func (r *iamRoleResource) Read(ctx context.Context, req resource.ReadRequest, re
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *iamRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	// The IAM Role API does not support updates. Therefore, we delete the old role and create a new one.
 	var state models.IamRoleModel
@@ -318,6 +324,7 @@ func (r *iamRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 // Delete deletes the resource and removes it from the Terraform state on success.
 func (r *iamRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state models.IamRoleModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -331,3 +338,48 @@ func (r *iamRoleResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 }
+
+// ImportState imports an existing IAM role by its role ID.
+func (r *iamRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	listResp, err := r.client.ListAllRoles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing IAM Role", err.Error())
+		return
+	}
+
+	var role *platformTypes.RoleListItem
+	for i := range listResp.Data {
+		if listResp.Data[i].RoleID == req.ID {
+			role = &listResp.Data[i]
+			break
+		}
+	}
+
+	if role == nil {
+		resp.Diagnostics.AddError("Error Importing IAM Role", fmt.Sprintf("No IAM role found with ID \"%s\".", req.ID))
+		return
+	}
+
+	// The state is null when importing, so the identifier is set first to
+	// allow the remaining attributes to be retrieved as typed null values.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), role.RoleID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.IamRoleModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.RefreshFromRemote(ctx, &resp.Diagnostics, role)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
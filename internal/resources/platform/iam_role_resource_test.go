@@ -99,6 +99,15 @@ func TestUnitIamRoleResource(t *testing.T) {
 					resource.TestCheckResourceAttr("cortexcloud_iam_role.test", "updated_ts", "1678886400000"),
 				),
 			},
+			// Step 3: Import
+			{
+				ResourceName:      "cortexcloud_iam_role.test",
+				ImportState:       true,
+				ImportStateId:     "test-role-id",
+				ImportStateVerify: true,
+				// Permissions are not returned by the list roles endpoint
+				ImportStateVerifyIgnore: []string{"component_permissions", "dataset_permissions"},
+			},
 		},
 	})
 }
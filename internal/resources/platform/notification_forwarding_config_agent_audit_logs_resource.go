@@ -5,9 +5,9 @@ package platform
 
 import (
 	"context"
-	"fmt"
 
 	sdk "github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+	platformTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/platform"
 	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/platform"
 	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
 	sharedModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/shared"
@@ -15,7 +15,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -83,7 +82,7 @@ func (r *notificationForwardingConfigAgentAuditLogsResource) Schema(ctx context.
 				Default:     booldefault.StaticBool(true),
 			},
 			"scope": schema.SingleNestedAttribute{
-				Description: "The filter for the configuration.",
+				Description: models.NotificationForwardingScopeDescription(models.NotificationForwardingConfigurationTypeAgentAuditLogs),
 				Optional:    true,
 				Computed:    true,
 				Attributes:  sharedModels.RootFilterAttributes,
@@ -170,7 +169,7 @@ func (r *notificationForwardingConfigAgentAuditLogsResource) Configure(ctx conte
 		return
 	}
 
-	r.client = client.Platform
+	r.client = client.Platform.Client(ctx, &resp.Diagnostics)
 }
 
 func (r *notificationForwardingConfigAgentAuditLogsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -181,18 +180,13 @@ func (r *notificationForwardingConfigAgentAuditLogsResource) ValidateConfig(ctx
 	}
 
 	// Throw error if no forwarding destinations have been configured
-	if config.EmailConfig == nil &&
-		config.SyslogConfig == nil {
-		resp.Diagnostics.AddError(
-			"Invalid Resource Configuration",
-			"At least one of the following attributes must be configured: email_config, syslog_config",
-		)
-	}
+	config.ValidateConfig(ctx, &resp.Diagnostics, resp)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *notificationForwardingConfigAgentAuditLogsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan *models.NotificationForwardingConfigurationAgentAuditLogsModel = &models.NotificationForwardingConfigurationAgentAuditLogsModel{}
 	plan.FromTfsdk(ctx, &resp.Diagnostics, req.Plan)
@@ -205,35 +199,11 @@ func (r *notificationForwardingConfigAgentAuditLogsResource) Create(ctx context.
 		return
 	}
 
-	createResp, err := r.client.CreateNotificationForwardingConfiguration(ctx, createReq)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Agent Audit Logs Notification Forwarding Configuration",
-			err.Error(),
-		)
+	createResp := createNotificationForwardingConfig(ctx, r.client, &resp.Diagnostics, "Agent Audit Logs Notification Forwarding Configuration", createReq, plan.Shared.Enabled.ValueBool())
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if !plan.Shared.Enabled.ValueBool() {
-		err := r.client.DisableNotificationForwardingConfiguration(ctx, createResp.ID)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Disabling Created Agent Audit Logs Notification Forwarding Configuration",
-				fmt.Sprintf("Error occurred while attempting to disable the notification forwarding configuration after creation: %s\n\nThe provider will now delete the new configuration.", err.Error()),
-			)
-
-			deleteErr := r.client.DeleteNotificationForwardingConfiguration(ctx, createResp.ID)
-			if deleteErr != nil {
-				resp.Diagnostics.AddError(
-					"Error Deleting New Agent Audit Logs Notification Forwarding Configuration",
-					fmt.Sprintf("Error occurred while attempting to delete notification forwarding configuration: %s\n\nNavigate to the Notifications configuration page in the Cortex Cloud console to manually remove the dangling configuration.", err.Error()),
-				)
-			}
-			return
-		}
-		createResp.Enabled = false
-	}
-
 	plan.RefreshFromRemote(ctx, &resp.Diagnostics, createResp)
 	if resp.Diagnostics.HasError() {
 		return
@@ -263,6 +233,7 @@ func (r *notificationForwardingConfigAgentAuditLogsResource) Create(ctx context.
 // Read refreshes the Terraform state with the latest data.
 func (r *notificationForwardingConfigAgentAuditLogsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state *models.NotificationForwardingConfigurationAgentAuditLogsModel = &models.NotificationForwardingConfigurationAgentAuditLogsModel{}
 	state.FromTfsdk(ctx, &resp.Diagnostics, req.State)
@@ -307,31 +278,10 @@ func (r *notificationForwardingConfigAgentAuditLogsResource) Read(ctx context.Co
 	})...)
 }
 
-func (r *notificationForwardingConfigAgentAuditLogsResource) setEnabledAttr(ctx context.Context, diagnostics *diag.Diagnostics, id string, enabled bool) {
-	var (
-		toggleErr error
-		op        string
-	)
-	if enabled {
-		op = "enable"
-		toggleErr = r.client.EnableNotificationForwardingConfiguration(ctx, id)
-	} else {
-		op = "disable"
-		toggleErr = r.client.DisableNotificationForwardingConfiguration(ctx, id)
-	}
-
-	if toggleErr != nil {
-		diagnostics.AddError(
-			"Error Toggling Agent Audit Logs Notification Forwarding Configuration",
-			fmt.Sprintf("Error occurred while attempting to %s notification forwarding configuration: %s", op, toggleErr.Error()),
-		)
-		return
-	}
-}
-
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *notificationForwardingConfigAgentAuditLogsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan *models.NotificationForwardingConfigurationAgentAuditLogsModel = &models.NotificationForwardingConfigurationAgentAuditLogsModel{}
 	plan.FromTfsdk(ctx, &resp.Diagnostics, req.Plan)
@@ -357,41 +307,28 @@ func (r *notificationForwardingConfigAgentAuditLogsResource) Update(ctx context.
 		isUpdatingOtherAttr = true
 	}
 
-	// Re-enable notification forwarding configuration if disabled
-	if !state.Shared.Enabled.ValueBool() {
-		r.setEnabledAttr(ctx, &resp.Diagnostics, state.Shared.ID.ValueString(), true)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
+	var updateReq *platformTypes.CreateOrUpdateNotificationForwardingConfigurationRequest
 	if isUpdatingOtherAttr {
-		updateReq := plan.ToCreateOrUpdateRequest(ctx, &resp.Diagnostics)
+		updateRequest := plan.ToCreateOrUpdateRequest(ctx, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		updateReq = &updateRequest
+	}
 
-		updateResp, err := r.client.UpdateNotificationForwardingConfiguration(ctx, state.Shared.ID.ValueString(), updateReq)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Updating Agent Audit Logs Notification Forwarding Configuration",
-				fmt.Sprintf("Error occurred while attempting to update notification forwarding configuration \"%s\": %s", state.Shared.Name.ValueString(), err.Error()),
-			)
-			return
-		}
+	updateResp := updateNotificationForwardingConfig(ctx, r.client, &resp.Diagnostics, "Agent Audit Logs Notification Forwarding Configuration", state.Shared.ID.ValueString(), state.Shared.Name.ValueString(), state.Shared.Enabled.ValueBool(), plan.Shared.Enabled.ValueBool(), updateReq)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		plan.RefreshFromRemote(ctx, &resp.Diagnostics, updateResp)
+	if updateResp != nil {
+		plan.RefreshFromRemote(ctx, &resp.Diagnostics, *updateResp)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
 	if isDisablingConfig {
-		r.setEnabledAttr(ctx, &resp.Diagnostics, state.Shared.ID.ValueString(), false)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Re-set enabled attribute to false, in case it was set to
 		// true while updating the other resource attributes
 		plan.Shared.Enabled = types.BoolValue(false)
@@ -421,6 +358,7 @@ func (r *notificationForwardingConfigAgentAuditLogsResource) Update(ctx context.
 // Delete deletes the resource and removes it from the Terraform state on success.
 func (r *notificationForwardingConfigAgentAuditLogsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state *models.NotificationForwardingConfigurationAgentAuditLogsModel = &models.NotificationForwardingConfigurationAgentAuditLogsModel{}
 	state.FromTfsdk(ctx, &resp.Diagnostics, req.State)
@@ -428,12 +366,9 @@ func (r *notificationForwardingConfigAgentAuditLogsResource) Delete(ctx context.
 		return
 	}
 
-	err := r.client.DeleteNotificationForwardingConfiguration(ctx, state.Shared.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting Agent Audit Logs Notification Forwarding Configuration",
-			err.Error(),
-		)
+	deleteNotificationForwardingConfig(ctx, r.client, &resp.Diagnostics, "Agent Audit Logs Notification Forwarding Configuration", state.Shared.ID.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.State.RemoveResource(ctx)
@@ -442,6 +377,7 @@ func (r *notificationForwardingConfigAgentAuditLogsResource) Delete(ctx context.
 // ImportState imports the resource into the Terraform state.
 func (r *notificationForwardingConfigAgentAuditLogsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -5,9 +5,9 @@ package platform
 
 import (
 	"context"
-	"fmt"
 
 	sdk "github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+	platformTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/platform"
 	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/platform"
 	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
 	sharedModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/shared"
@@ -22,7 +22,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -34,9 +33,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &notificationForwardingConfigCasesResource{}
-	_ resource.ResourceWithConfigure   = &notificationForwardingConfigCasesResource{}
-	_ resource.ResourceWithImportState = &notificationForwardingConfigCasesResource{}
+	_ resource.Resource                   = &notificationForwardingConfigCasesResource{}
+	_ resource.ResourceWithConfigure      = &notificationForwardingConfigCasesResource{}
+	_ resource.ResourceWithImportState    = &notificationForwardingConfigCasesResource{}
+	_ resource.ResourceWithValidateConfig = &notificationForwardingConfigCasesResource{}
 )
 
 // NewNotificationForwardingConfigCasesResource is a helper function to simplify the provider implementation.
@@ -82,7 +82,7 @@ func (r *notificationForwardingConfigCasesResource) Schema(ctx context.Context,
 				Default:     booldefault.StaticBool(true),
 			},
 			"scope": schema.SingleNestedAttribute{
-				Description: "The filter for the configuration.",
+				Description: models.NotificationForwardingScopeDescription(models.NotificationForwardingConfigurationTypeCases),
 				Optional:    true,
 				Computed:    true,
 				Attributes:  sharedModels.RootFilterAttributes,
@@ -153,12 +153,24 @@ func (r *notificationForwardingConfigCasesResource) Configure(ctx context.Contex
 		return
 	}
 
-	r.client = client.Platform
+	r.client = client.Platform.Client(ctx, &resp.Diagnostics)
+}
+
+func (r *notificationForwardingConfigCasesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config *models.NotificationForwardingConfigurationCasesModel = &models.NotificationForwardingConfigurationCasesModel{}
+	config.FromTfsdk(ctx, &resp.Diagnostics, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Throw error if the scope references unsupported fields
+	config.ValidateConfig(ctx, &resp.Diagnostics, resp)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *notificationForwardingConfigCasesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan *models.NotificationForwardingConfigurationCasesModel = &models.NotificationForwardingConfigurationCasesModel{}
 	plan.FromTfsdk(ctx, &resp.Diagnostics, req.Plan)
@@ -171,35 +183,11 @@ func (r *notificationForwardingConfigCasesResource) Create(ctx context.Context,
 		return
 	}
 
-	createResp, err := r.client.CreateNotificationForwardingConfiguration(ctx, createReq)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Cases Notification Forwarding Configuration",
-			err.Error(),
-		)
+	createResp := createNotificationForwardingConfig(ctx, r.client, &resp.Diagnostics, "Cases Notification Forwarding Configuration", createReq, plan.Shared.Enabled.ValueBool())
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if !plan.Shared.Enabled.ValueBool() {
-		err := r.client.DisableNotificationForwardingConfiguration(ctx, createResp.ID)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Disabling Created Cases Notification Forwarding Configuration",
-				fmt.Sprintf("Error occurred while attempting to disable the notification forwarding configuration after creation: %s\n\nThe provider will now delete the new configuration.", err.Error()),
-			)
-
-			deleteErr := r.client.DeleteNotificationForwardingConfiguration(ctx, createResp.ID)
-			if deleteErr != nil {
-				resp.Diagnostics.AddError(
-					"Error Deleting New Cases Notification Forwarding Configuration",
-					fmt.Sprintf("Error occurred while attempting to delete notification forwarding configuration: %s\n\nNavigate to the Notifications configuration page in the Cortex Cloud console to manually remove the dangling configuration.", err.Error()),
-				)
-			}
-			return
-		}
-		createResp.Enabled = false
-	}
-
 	plan.RefreshFromRemote(ctx, &resp.Diagnostics, createResp)
 	if resp.Diagnostics.HasError() {
 		return
@@ -227,6 +215,7 @@ func (r *notificationForwardingConfigCasesResource) Create(ctx context.Context,
 // Read refreshes the Terraform state with the latest data.
 func (r *notificationForwardingConfigCasesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state *models.NotificationForwardingConfigurationCasesModel = &models.NotificationForwardingConfigurationCasesModel{}
 	state.FromTfsdk(ctx, &resp.Diagnostics, req.State)
@@ -266,31 +255,10 @@ func (r *notificationForwardingConfigCasesResource) Read(ctx context.Context, re
 	})...)
 }
 
-func (r *notificationForwardingConfigCasesResource) setEnabledAttr(ctx context.Context, diagnostics *diag.Diagnostics, id string, enabled bool) {
-	var (
-		toggleErr error
-		op        string
-	)
-	if enabled {
-		op = "enable"
-		toggleErr = r.client.EnableNotificationForwardingConfiguration(ctx, id)
-	} else {
-		op = "disable"
-		toggleErr = r.client.DisableNotificationForwardingConfiguration(ctx, id)
-	}
-
-	if toggleErr != nil {
-		diagnostics.AddError(
-			"Error Toggling Cases Notification Forwarding Configuration",
-			fmt.Sprintf("Error occurred while attempting to %s notification forwarding configuration: %s", op, toggleErr.Error()),
-		)
-		return
-	}
-}
-
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *notificationForwardingConfigCasesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan *models.NotificationForwardingConfigurationCasesModel = &models.NotificationForwardingConfigurationCasesModel{}
 	plan.FromTfsdk(ctx, &resp.Diagnostics, req.Plan)
@@ -316,41 +284,28 @@ func (r *notificationForwardingConfigCasesResource) Update(ctx context.Context,
 		isUpdatingOtherAttr = true
 	}
 
-	// Re-enable notification forwarding configuration if disabled
-	if !state.Shared.Enabled.ValueBool() {
-		r.setEnabledAttr(ctx, &resp.Diagnostics, state.Shared.ID.ValueString(), true)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
+	var updateReq *platformTypes.CreateOrUpdateNotificationForwardingConfigurationRequest
 	if isUpdatingOtherAttr {
-		updateReq := plan.ToCreateOrUpdateRequest(ctx, &resp.Diagnostics)
+		updateRequest := plan.ToCreateOrUpdateRequest(ctx, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		updateReq = &updateRequest
+	}
 
-		updateResp, err := r.client.UpdateNotificationForwardingConfiguration(ctx, state.Shared.ID.ValueString(), updateReq)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Updating Cases Notification Forwarding Configuration",
-				fmt.Sprintf("Error occurred while attempting to update notification forwarding configuration \"%s\": %s", state.Shared.Name.ValueString(), err.Error()),
-			)
-			return
-		}
+	updateResp := updateNotificationForwardingConfig(ctx, r.client, &resp.Diagnostics, "Cases Notification Forwarding Configuration", state.Shared.ID.ValueString(), state.Shared.Name.ValueString(), state.Shared.Enabled.ValueBool(), plan.Shared.Enabled.ValueBool(), updateReq)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		plan.RefreshFromRemote(ctx, &resp.Diagnostics, updateResp)
+	if updateResp != nil {
+		plan.RefreshFromRemote(ctx, &resp.Diagnostics, *updateResp)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
 	if isDisablingConfig {
-		r.setEnabledAttr(ctx, &resp.Diagnostics, state.Shared.ID.ValueString(), false)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
 		// Re-set enabled attribute to false, in case it was set to
 		// true while updating the other resource attributes
 		plan.Shared.Enabled = types.BoolValue(false)
@@ -378,6 +333,7 @@ func (r *notificationForwardingConfigCasesResource) Update(ctx context.Context,
 // Delete deletes the resource and removes it from the Terraform state on success.
 func (r *notificationForwardingConfigCasesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state *models.NotificationForwardingConfigurationCasesModel = &models.NotificationForwardingConfigurationCasesModel{}
 	state.FromTfsdk(ctx, &resp.Diagnostics, req.State)
@@ -385,12 +341,9 @@ func (r *notificationForwardingConfigCasesResource) Delete(ctx context.Context,
 		return
 	}
 
-	err := r.client.DeleteNotificationForwardingConfiguration(ctx, state.Shared.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting Cases Notification Forwarding Configuration",
-			err.Error(),
-		)
+	deleteNotificationForwardingConfig(ctx, r.client, &resp.Diagnostics, "Cases Notification Forwarding Configuration", state.Shared.ID.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.State.RemoveResource(ctx)
@@ -399,6 +352,7 @@ func (r *notificationForwardingConfigCasesResource) Delete(ctx context.Context,
 // ImportState imports the resource into the Terraform state.
 func (r *notificationForwardingConfigCasesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -0,0 +1,135 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+	platformTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/platform"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// The functions in this file implement the API calls shared by the
+// notification forwarding configuration resources. The given title is the
+// name of the configuration kind used in diagnostic summaries, e.g.
+// "Issues Notification Forwarding Configuration".
+
+// setNotificationForwardingConfigEnabled enables or disables the
+// notification forwarding configuration with the given ID.
+func setNotificationForwardingConfigEnabled(ctx context.Context, client *sdk.Client, diagnostics *diag.Diagnostics, title string, id string, enabled bool) {
+	var (
+		toggleErr error
+		op        string
+	)
+	if enabled {
+		op = "enable"
+		toggleErr = client.EnableNotificationForwardingConfiguration(ctx, id)
+	} else {
+		op = "disable"
+		toggleErr = client.DisableNotificationForwardingConfiguration(ctx, id)
+	}
+
+	if toggleErr != nil {
+		diagnostics.AddError(
+			fmt.Sprintf("Error Toggling %s", title),
+			fmt.Sprintf("Error occurred while attempting to %s notification forwarding configuration: %s", op, toggleErr.Error()),
+		)
+		return
+	}
+}
+
+// createNotificationForwardingConfig creates a notification forwarding
+// configuration and returns the API response. Configurations are always
+// created enabled, so the new configuration is disabled afterwards if
+// enabled is false. If it cannot be disabled, the new configuration is
+// deleted so that it does not forward notifications without being tracked
+// in the Terraform state.
+func createNotificationForwardingConfig(ctx context.Context, client *sdk.Client, diagnostics *diag.Diagnostics, title string, createReq platformTypes.CreateOrUpdateNotificationForwardingConfigurationRequest, enabled bool) platformTypes.NotificationForwardingConfiguration {
+	createResp, err := client.CreateNotificationForwardingConfiguration(ctx, createReq)
+	if err != nil {
+		diagnostics.AddError(
+			fmt.Sprintf("Error Creating %s", title),
+			err.Error(),
+		)
+		return createResp
+	}
+
+	if !enabled {
+		err := client.DisableNotificationForwardingConfiguration(ctx, createResp.ID)
+		if err != nil {
+			diagnostics.AddError(
+				fmt.Sprintf("Error Disabling Created %s", title),
+				fmt.Sprintf("Error occurred while attempting to disable the notification forwarding configuration after creation: %s\n\nThe provider will now delete the new configuration.", err.Error()),
+			)
+
+			deleteErr := client.DeleteNotificationForwardingConfiguration(ctx, createResp.ID)
+			if deleteErr != nil {
+				diagnostics.AddError(
+					fmt.Sprintf("Error Deleting New %s", title),
+					fmt.Sprintf("Error occurred while attempting to delete notification forwarding configuration: %s\n\nNavigate to the Notifications configuration page in the Cortex Cloud console to manually remove the dangling configuration.", deleteErr.Error()),
+				)
+			}
+			return createResp
+		}
+		createResp.Enabled = false
+	}
+
+	return createResp
+}
+
+// updateNotificationForwardingConfig updates the notification forwarding
+// configuration with the given ID and name. Disabled configurations cannot
+// be updated, so a configuration that was disabled is enabled before
+// sending updateReq and disabled again afterwards if enabled is false. If
+// updateReq is nil, only the enabled status of the configuration is
+// changed.
+//
+// The API response to the update request is returned, or nil if no update
+// request was sent.
+func updateNotificationForwardingConfig(ctx context.Context, client *sdk.Client, diagnostics *diag.Diagnostics, title string, id string, name string, wasEnabled bool, enabled bool, updateReq *platformTypes.CreateOrUpdateNotificationForwardingConfigurationRequest) *platformTypes.NotificationForwardingConfiguration {
+	// Re-enable notification forwarding configuration if disabled
+	if !wasEnabled {
+		setNotificationForwardingConfigEnabled(ctx, client, diagnostics, title, id, true)
+		if diagnostics.HasError() {
+			return nil
+		}
+	}
+
+	var updateResp *platformTypes.NotificationForwardingConfiguration
+	if updateReq != nil {
+		remote, err := client.UpdateNotificationForwardingConfiguration(ctx, id, *updateReq)
+		if err != nil {
+			diagnostics.AddError(
+				fmt.Sprintf("Error Updating %s", title),
+				fmt.Sprintf("Error occurred while attempting to update notification forwarding configuration \"%s\": %s", name, err.Error()),
+			)
+			return nil
+		}
+		updateResp = &remote
+	}
+
+	if !enabled {
+		setNotificationForwardingConfigEnabled(ctx, client, diagnostics, title, id, false)
+		if diagnostics.HasError() {
+			return nil
+		}
+	}
+
+	return updateResp
+}
+
+// deleteNotificationForwardingConfig deletes the notification forwarding
+// configuration with the given ID.
+func deleteNotificationForwardingConfig(ctx context.Context, client *sdk.Client, diagnostics *diag.Diagnostics, title string, id string) {
+	err := client.DeleteNotificationForwardingConfiguration(ctx, id)
+	if err != nil {
+		diagnostics.AddError(
+			fmt.Sprintf("Error Deleting %s", title),
+			err.Error(),
+		)
+	}
+}
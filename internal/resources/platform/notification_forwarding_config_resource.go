@@ -0,0 +1,442 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/PaloAltoNetworks/cortex-cloud-go/enums"
+	sdk "github.com/PaloAltoNetworks/cortex-cloud-go/platform"
+	platformTypes "github.com/PaloAltoNetworks/cortex-cloud-go/types/platform"
+	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/platform"
+	providerModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	sharedModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/shared"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/planmodifiers"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &notificationForwardingConfigResource{}
+	_ resource.ResourceWithConfigure      = &notificationForwardingConfigResource{}
+	_ resource.ResourceWithImportState    = &notificationForwardingConfigResource{}
+	_ resource.ResourceWithValidateConfig = &notificationForwardingConfigResource{}
+	_ resource.ResourceWithMoveState      = &notificationForwardingConfigResource{}
+)
+
+// NewNotificationForwardingConfigResource is a helper function to simplify the provider implementation.
+func NewNotificationForwardingConfigResource() resource.Resource {
+	return &notificationForwardingConfigResource{}
+}
+
+// notificationForwardingConfigResource is the resource implementation.
+type notificationForwardingConfigResource struct {
+	client *sdk.Client
+}
+
+// Metadata returns the resource type name.
+func (r *notificationForwardingConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_forwarding_config"
+}
+
+func (r *notificationForwardingConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// The format attributes default to "issue" for issues, and are null for
+	// every other type
+	formatPlanModifiers := []planmodifier.String{
+		planmodifiers.StringDefaultIfAttributeEquals(
+			path.Root("type"),
+			types.StringValue(models.NotificationForwardingConfigurationTypeIssues),
+			enums.NotificationFormatIssue.String(),
+		),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a notification forwarding configuration of any type.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the configuration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseNonNullStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("The type of notifications forwarded by the configuration. Possible values are \"%s\". Changing this value forces a new configuration to be created.", strings.Join(models.NotificationForwardingConfigurationTypeEnums, `", "`)),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(models.NotificationForwardingConfigurationTypeEnums...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the configuration.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the configuration.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "The status of the configuration.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"scope": schema.SingleNestedAttribute{
+				Description: models.NotificationForwardingScopeDescription(""),
+				Optional:    true,
+				Computed:    true,
+				Attributes:  sharedModels.RootFilterAttributes,
+				Default: objectdefault.StaticValue(types.ObjectNull(
+					sharedModels.RootFilterAttrTypeMap,
+				)),
+			},
+			"email_config": schema.SingleNestedAttribute{
+				Description: "Configure notification forwarding for a list of email addresses.",
+				Optional:    true,
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"distribution_list": schema.ListAttribute{
+						Description: "The email addresses that notifications will be sent to.",
+						Required:    true,
+						ElementType: types.StringType,
+					},
+					"subject": schema.StringAttribute{
+						Description: "The subject that will be used for notification emails. Leave blank to have Cortex Cloud auto-generate a subject. Must not exceed 256 characters.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(""),
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(256),
+						},
+					},
+					"format": schema.StringAttribute{
+						Description: fmt.Sprintf("The format that will be used for the email body. Possible values are \"%s\". May only be configured when `type` is \"%s\", for which the default value is \"%s\".", strings.Join(enums.AllNotificationFormats(), `", "`), models.NotificationForwardingConfigurationTypeIssues, enums.NotificationFormatIssue.String()),
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(enums.AllNotificationFormats()...),
+						},
+						PlanModifiers: formatPlanModifiers,
+					},
+					"grouping_timeframe": schema.Int32Attribute{
+						Description: "The time frame, in minutes, that specifies how often Cortex Cloud sends notifications. Set to 0 to have Cortex Cloud send a notification for each event. Must be a value between 0 and 1440, inclusive. Default value is 10.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int32default.StaticInt32(10),
+						Validators: []validator.Int32{
+							int32validator.Between(0, 1440),
+						},
+					},
+				},
+				Default: objectdefault.StaticValue(types.ObjectNull(
+					map[string]attr.Type{
+						"distribution_list": types.ListType{
+							ElemType: types.StringType,
+						},
+						"subject":            types.StringType,
+						"grouping_timeframe": types.Int32Type,
+						"format":             types.StringType,
+					},
+				)),
+			},
+			"syslog_config": schema.SingleNestedAttribute{
+				Description: fmt.Sprintf("Configure notification forwarding to a syslog server. May not be configured when `type` is \"%s\".", models.NotificationForwardingConfigurationTypeCases),
+				Optional:    true,
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"server_id": schema.Int64Attribute{
+						Description: "The ID of the syslog server to forward notifications to.",
+						Required:    true,
+					},
+					"format": schema.StringAttribute{
+						Description: fmt.Sprintf("The format that will be used for the syslog message body. Possible values are \"%s\". May only be configured when `type` is \"%s\", for which the default value is \"%s\".", strings.Join(enums.AllNotificationFormats(), `", "`), models.NotificationForwardingConfigurationTypeIssues, enums.NotificationFormatIssue.String()),
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(enums.AllNotificationFormats()...),
+						},
+						PlanModifiers: formatPlanModifiers,
+					},
+				},
+				Default: objectdefault.StaticValue(types.ObjectNull(
+					map[string]attr.Type{
+						"server_id": types.Int64Type,
+						"format":    types.StringType,
+					},
+				)),
+			},
+			"timezone": schema.StringAttribute{
+				Description: "The timezone used by the configuration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseNonNullStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the resource.
+func (r *notificationForwardingConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerModels.CortexCloudSDKClients)
+
+	if !ok {
+		util.AddUnexpectedResourceConfigurationTypeError(&resp.Diagnostics, "*providerModels.CortexCloudSDKClients", req.ProviderData)
+		return
+	}
+
+	r.client = client.Platform.Client(ctx, &resp.Diagnostics)
+}
+
+func (r *notificationForwardingConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config *models.NotificationForwardingConfigurationModel = &models.NotificationForwardingConfigurationModel{}
+	config.FromTfsdk(ctx, &resp.Diagnostics, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Throw error if the forwarding destinations or formats are not
+	// supported by the configured type
+	config.ValidateConfig(ctx, &resp.Diagnostics, resp)
+}
+
+// MoveState returns the state movers that allow the typed notification
+// forwarding configuration resources to be moved to this resource using a
+// moved block.
+func (r *notificationForwardingConfigResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		notificationForwardingConfigStateMover(ctx, NewNotificationForwardingConfigAgentAuditLogsResource, func(ctx context.Context, diags *diag.Diagnostics, state *tfsdk.State) models.NotificationForwardingConfigurationModel {
+			source := &models.NotificationForwardingConfigurationAgentAuditLogsModel{}
+			source.FromTfsdk(ctx, diags, state)
+			return source.ToNotificationForwardingConfigurationModel()
+		}),
+		notificationForwardingConfigStateMover(ctx, NewNotificationForwardingConfigManagementAuditLogsResource, func(ctx context.Context, diags *diag.Diagnostics, state *tfsdk.State) models.NotificationForwardingConfigurationModel {
+			source := &models.NotificationForwardingConfigurationMgmtAuditLogsModel{}
+			source.FromTfsdk(ctx, diags, state)
+			return source.ToNotificationForwardingConfigurationModel()
+		}),
+		notificationForwardingConfigStateMover(ctx, NewNotificationForwardingConfigCasesResource, func(ctx context.Context, diags *diag.Diagnostics, state *tfsdk.State) models.NotificationForwardingConfigurationModel {
+			source := &models.NotificationForwardingConfigurationCasesModel{}
+			source.FromTfsdk(ctx, diags, state)
+			return source.ToNotificationForwardingConfigurationModel()
+		}),
+		notificationForwardingConfigStateMover(ctx, NewNotificationForwardingConfigIssuesResource, func(ctx context.Context, diags *diag.Diagnostics, state *tfsdk.State) models.NotificationForwardingConfigurationModel {
+			source := &models.NotificationForwardingConfigurationIssuesModel{}
+			source.FromTfsdk(ctx, diags, state)
+			return source.ToNotificationForwardingConfigurationModel()
+		}),
+	}
+}
+
+// notificationForwardingConfigStateMover returns a state mover that converts
+// the state of the typed resource returned by newSourceResource using the
+// given conversion function.
+func notificationForwardingConfigStateMover(ctx context.Context, newSourceResource func() resource.Resource, convert func(ctx context.Context, diags *diag.Diagnostics, state *tfsdk.State) models.NotificationForwardingConfigurationModel) resource.StateMover {
+	sourceResource := newSourceResource()
+
+	var metadataResp resource.MetadataResponse
+	sourceResource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "cortexcloud"}, &metadataResp)
+
+	var schemaResp resource.SchemaResponse
+	sourceResource.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	return resource.StateMover{
+		SourceSchema: &schemaResp.Schema,
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			defer util.PanicHandler(&resp.Diagnostics)
+
+			// Leave the request to the other state movers if it was not
+			// sent for this source resource type
+			if req.SourceTypeName != metadataResp.TypeName || req.SourceState == nil {
+				return
+			}
+
+			tflog.Debug(ctx, "Moving notification forwarding configuration state", map[string]any{
+				"source_type_name": req.SourceTypeName,
+			})
+
+			state := convert(ctx, &resp.Diagnostics, req.SourceState)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			state.ToTfsdk(ctx, &resp.Diagnostics, &resp.TargetState)
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *notificationForwardingConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	var plan *models.NotificationForwardingConfigurationModel = &models.NotificationForwardingConfigurationModel{}
+	plan.FromTfsdk(ctx, &resp.Diagnostics, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := plan.ToCreateOrUpdateRequest(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp := createNotificationForwardingConfig(ctx, r.client, &resp.Diagnostics, "Notification Forwarding Configuration", createReq, plan.Shared.Enabled.ValueBool())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.RefreshFromRemote(ctx, &resp.Diagnostics, createResp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ToTfsdk(ctx, &resp.Diagnostics, &resp.State)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *notificationForwardingConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	var state *models.NotificationForwardingConfigurationModel = &models.NotificationForwardingConfigurationModel{}
+	state.FromTfsdk(ctx, &resp.Diagnostics, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote, err := r.client.GetNotificationForwardingConfiguration(ctx, state.Shared.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Notification Forwarding Configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	state.RefreshFromRemote(ctx, &resp.Diagnostics, remote)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	tflog.Debug(ctx, "Setting refreshed state")
+	state.ToTfsdk(ctx, &resp.Diagnostics, &resp.State)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *notificationForwardingConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	var plan *models.NotificationForwardingConfigurationModel = &models.NotificationForwardingConfigurationModel{}
+	plan.FromTfsdk(ctx, &resp.Diagnostics, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *models.NotificationForwardingConfigurationModel = &models.NotificationForwardingConfigurationModel{}
+	state.FromTfsdk(ctx, &resp.Diagnostics, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		isDisablingConfig   bool = !plan.Shared.Enabled.ValueBool()
+		isUpdatingOtherAttr bool = false
+	)
+
+	if !plan.Shared.ID.Equal(state.Shared.ID) || !plan.Shared.Name.Equal(state.Shared.Name) ||
+		!plan.Shared.Description.Equal(state.Shared.Description) || !plan.Shared.Timezone.Equal(state.Shared.Timezone) ||
+		!plan.EmailConfig.Equals(state.EmailConfig) || !plan.SyslogConfig.Equals(state.SyslogConfig) || !plan.Shared.Scope.Equals(state.Shared.Scope) {
+		tflog.Trace(ctx, "Updating other fields in notification forwarding configuration")
+		isUpdatingOtherAttr = true
+	}
+
+	var updateReq *platformTypes.CreateOrUpdateNotificationForwardingConfigurationRequest
+	if isUpdatingOtherAttr {
+		updateRequest := plan.ToCreateOrUpdateRequest(ctx, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq = &updateRequest
+	}
+
+	updateResp := updateNotificationForwardingConfig(ctx, r.client, &resp.Diagnostics, "Notification Forwarding Configuration", state.Shared.ID.ValueString(), state.Shared.Name.ValueString(), state.Shared.Enabled.ValueBool(), plan.Shared.Enabled.ValueBool(), updateReq)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if updateResp != nil {
+		plan.RefreshFromRemote(ctx, &resp.Diagnostics, *updateResp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if isDisablingConfig {
+		// Re-set enabled attribute to false, in case it was set to
+		// true while updating the other resource attributes
+		plan.Shared.Enabled = types.BoolValue(false)
+	}
+
+	plan.ToTfsdk(ctx, &resp.Diagnostics, &resp.State)
+}
+
+// Delete deletes the resource and removes it from the Terraform state on success.
+func (r *notificationForwardingConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	var state *models.NotificationForwardingConfigurationModel = &models.NotificationForwardingConfigurationModel{}
+	state.FromTfsdk(ctx, &resp.Diagnostics, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteNotificationForwardingConfig(ctx, r.client, &resp.Diagnostics, "Notification Forwarding Configuration", state.Shared.ID.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *notificationForwardingConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -4,9 +4,11 @@
 package platform_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -15,11 +17,21 @@ import (
 	"github.com/PaloAltoNetworks/cortex-cloud-go/enums"
 	sdk "github.com/PaloAltoNetworks/cortex-cloud-go/platform"
 
+	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/platform"
 	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/provider"
+	platformResources "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/resources/platform"
 	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/tests"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -722,6 +734,21 @@ func TestUnitNotificationManagementConfigAgentAuditLogsResource_Lifecycle(t *tes
 		createTestSyslogConfig,
 	)
 
+	// Generate resource configuration without any forwarding destinations
+	// for ValidateConfig test
+	invalidTestConfig := fmt.Sprintf(
+		notificationForwardingConfigTestResourceTmpl,
+		providerConfig,
+		resourceType,
+		resourceName,
+		testNotificationConfig1Name,
+		testNotificationConfig1Description,
+		testNotificationConfig1Enabled,
+		createTestScope,
+		"",
+		"",
+	)
+
 	// Generate resource configurations for Update test
 	updateTestSyslogConfig := fmt.Sprintf(
 		notificationForwardingConfigTestSyslogTmpl,
@@ -749,6 +776,10 @@ func TestUnitNotificationManagementConfigAgentAuditLogsResource_Lifecycle(t *tes
 			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
 		},
 		Steps: []resource.TestStep{
+			{
+				Config:      invalidTestConfig,
+				ExpectError: regexp.MustCompile(`email_config,\s+syslog_config`),
+			},
 			{
 				Config: createTestConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -791,3 +822,265 @@ func TestUnitNotificationManagementConfigAgentAuditLogsResource_Lifecycle(t *tes
 		},
 	})
 }
+
+func TestUnitNotificationForwardingConfigResource_ValidateConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, fmt.Sprintf("[%s] Endpoint not found: %s %s", t.Name(), r.Method, r.URL.Path), http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	providerConfig := tests.GetProviderConfig(t, &server.URL, "../../../../.env.test", true)
+	emailConfig := fmt.Sprintf(
+		notificationForwardingConfigTestEmailTmpl,
+		strings.Join(testNotificationConfig1Emails, "\", \""),
+		testNotificationConfig1EmailAggregation,
+		testNotificationConfig1EmailSubject,
+	)
+	syslogConfig := fmt.Sprintf(
+		notificationForwardingConfigTestSyslogTmpl,
+		testNotificationConfig1SyslogServerID,
+	)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cortexcloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Cases may not be forwarded to a syslog server
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "cortexcloud_notification_forwarding_config" "test" {
+  name = "%s"
+  type = "cases"
+  %s
+  %s
+}`, providerConfig, testNotificationConfig1Name, emailConfig, syslogConfig),
+				ExpectError: regexp.MustCompile(`syslog_config attribute may not be configured`),
+			},
+			// Only issues support a custom email format
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "cortexcloud_notification_forwarding_config" "test" {
+  name = "%s"
+  type = "agent_audit_logs"
+  email_config = {
+    distribution_list = ["%s"]
+    format = "%s"
+  }
+}`, providerConfig, testNotificationConfig1Name, testNotificationConfig1Email1, enums.NotificationFormatIssue.String()),
+				ExpectError: regexp.MustCompile(`email_config.format attribute may only be configured`),
+			},
+			// Scope search conditions must be complete
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "cortexcloud_notification_forwarding_config" "test" {
+  name = "%s"
+  type = "issues"
+  %s
+  scope = {
+    and = [
+      {
+        search_field = "SEVERITY"
+        search_type = "EQ"
+      }
+    ]
+  }
+}`, providerConfig, testNotificationConfig1Name, emailConfig),
+				ExpectError: regexp.MustCompile(`"search_value" attribute must be configured`),
+			},
+			// Search types must be supported by verified scope search fields
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "cortexcloud_notification_forwarding_config" "test" {
+  name = "%s"
+  type = "issues"
+  %s
+  scope = {
+    and = [
+      {
+        search_field = "ALERT_NAME"
+        search_type = "CONTAIN"
+        search_value = "malware"
+      }
+    ]
+  }
+}`, providerConfig, testNotificationConfig1Name, emailConfig),
+				ExpectError: regexp.MustCompile(`Did you mean "CONTAINS"\?`),
+			},
+		},
+	})
+}
+
+// newNullState returns a null Terraform state for the given resource
+// schema.
+func newNullState(ctx context.Context, resourceSchema fwresource.SchemaResponse) tfsdk.State {
+	return tfsdk.State{
+		Schema: resourceSchema.Schema,
+		Raw:    tftypes.NewValue(resourceSchema.Schema.Type().TerraformType(ctx), nil),
+	}
+}
+
+func TestUnitNotificationForwardingConfigResource_MoveState(t *testing.T) {
+	ctx := context.Background()
+
+	emailConfig := &models.EmailConfigModel{
+		DistributionList:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue(testNotificationConfig1Email1)}),
+		Subject:           types.StringValue(testNotificationConfig1EmailSubject),
+		GroupingTimeframe: types.Int32Value(int32(testNotificationConfig1EmailAggregation)),
+	}
+	syslogConfig := &models.SyslogConfigModel{
+		ServerID: types.Int64Value(int64(testNotificationConfig1SyslogServerID)),
+	}
+
+	tests := []struct {
+		name                 string
+		newSourceResource    func() fwresource.Resource
+		sourceAttributes     map[string]any
+		expectedType         string
+		expectedEmailFormat  types.String
+		expectedSyslogServer types.Int64
+	}{
+		{
+			name:              "agent audit logs",
+			newSourceResource: platformResources.NewNotificationForwardingConfigAgentAuditLogsResource,
+			sourceAttributes: map[string]any{
+				"email_config":  emailConfig,
+				"syslog_config": syslogConfig,
+			},
+			expectedType:         models.NotificationForwardingConfigurationTypeAgentAuditLogs,
+			expectedEmailFormat:  types.StringNull(),
+			expectedSyslogServer: syslogConfig.ServerID,
+		},
+		{
+			name:              "management audit logs",
+			newSourceResource: platformResources.NewNotificationForwardingConfigManagementAuditLogsResource,
+			sourceAttributes: map[string]any{
+				"email_config":  emailConfig,
+				"syslog_config": syslogConfig,
+			},
+			expectedType:         models.NotificationForwardingConfigurationTypeMgmtAuditLogs,
+			expectedEmailFormat:  types.StringNull(),
+			expectedSyslogServer: syslogConfig.ServerID,
+		},
+		{
+			name:              "cases",
+			newSourceResource: platformResources.NewNotificationForwardingConfigCasesResource,
+			sourceAttributes: map[string]any{
+				"email_config": emailConfig,
+			},
+			expectedType:         models.NotificationForwardingConfigurationTypeCases,
+			expectedEmailFormat:  types.StringNull(),
+			expectedSyslogServer: types.Int64Null(),
+		},
+		{
+			name:              "issues",
+			newSourceResource: platformResources.NewNotificationForwardingConfigIssuesResource,
+			sourceAttributes: map[string]any{
+				"email_config": &models.EmailConfigIssuesModel{
+					DistributionList:  emailConfig.DistributionList,
+					Subject:           emailConfig.Subject,
+					Format:            types.StringValue(enums.NotificationFormatIssue.String()),
+					GroupingTimeframe: emailConfig.GroupingTimeframe,
+				},
+				"syslog_config": &models.SyslogConfigIssuesModel{
+					ServerID: syslogConfig.ServerID,
+					Format:   types.StringValue(enums.NotificationFormatIssue.String()),
+				},
+			},
+			expectedType:         models.NotificationForwardingConfigurationTypeIssues,
+			expectedEmailFormat:  types.StringValue(enums.NotificationFormatIssue.String()),
+			expectedSyslogServer: syslogConfig.ServerID,
+		},
+	}
+
+	target := platformResources.NewNotificationForwardingConfigResource()
+	var targetSchema fwresource.SchemaResponse
+	target.Schema(ctx, fwresource.SchemaRequest{}, &targetSchema)
+	require.False(t, targetSchema.Diagnostics.HasError())
+
+	targetWithMoveState, ok := target.(fwresource.ResourceWithMoveState)
+	require.True(t, ok)
+	stateMovers := targetWithMoveState.MoveState(ctx)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := tt.newSourceResource()
+
+			var metadataResp fwresource.MetadataResponse
+			source.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "cortexcloud"}, &metadataResp)
+
+			var sourceSchema fwresource.SchemaResponse
+			source.Schema(ctx, fwresource.SchemaRequest{}, &sourceSchema)
+			require.False(t, sourceSchema.Diagnostics.HasError())
+
+			sourceState := newNullState(ctx, sourceSchema)
+			sourceAttributes := map[string]any{
+				"id":          types.StringValue(testNotificationConfig1ID),
+				"name":        types.StringValue(testNotificationConfig1Name),
+				"description": types.StringValue(testNotificationConfig1Description),
+				"enabled":     types.BoolValue(false),
+				"timezone":    types.StringValue("UTC"),
+			}
+			for name, value := range tt.sourceAttributes {
+				sourceAttributes[name] = value
+			}
+			for name, value := range sourceAttributes {
+				diags := sourceState.SetAttribute(ctx, path.Root(name), value)
+				require.False(t, diags.HasError(), "setting %s: %v", name, diags)
+			}
+
+			// Only the state mover for the source resource type may move
+			// the state
+			var moved []fwresource.MoveStateResponse
+			for _, stateMover := range stateMovers {
+				req := fwresource.MoveStateRequest{
+					SourceTypeName: metadataResp.TypeName,
+					SourceState:    &sourceState,
+				}
+				resp := fwresource.MoveStateResponse{
+					TargetState: newNullState(ctx, targetSchema),
+				}
+
+				stateMover.StateMover(ctx, req, &resp)
+				require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+				if !resp.TargetState.Raw.IsNull() {
+					moved = append(moved, resp)
+				}
+			}
+			require.Len(t, moved, 1)
+			targetState := moved[0].TargetState
+
+			var (
+				configType   types.String
+				id           types.String
+				enabled      types.Bool
+				subject      types.String
+				emailFormat  types.String
+				syslogServer types.Int64
+			)
+			require.False(t, targetState.GetAttribute(ctx, path.Root("type"), &configType).HasError())
+			require.False(t, targetState.GetAttribute(ctx, path.Root("id"), &id).HasError())
+			require.False(t, targetState.GetAttribute(ctx, path.Root("enabled"), &enabled).HasError())
+			require.False(t, targetState.GetAttribute(ctx, path.Root("email_config").AtName("subject"), &subject).HasError())
+			require.False(t, targetState.GetAttribute(ctx, path.Root("email_config").AtName("format"), &emailFormat).HasError())
+			require.False(t, targetState.GetAttribute(ctx, path.Root("syslog_config").AtName("server_id"), &syslogServer).HasError())
+
+			assert.Equal(t, tt.expectedType, configType.ValueString())
+			assert.Equal(t, testNotificationConfig1ID, id.ValueString())
+			assert.False(t, enabled.ValueBool())
+			assert.Equal(t, testNotificationConfig1EmailSubject, subject.ValueString())
+			assert.True(t, tt.expectedEmailFormat.Equal(emailFormat), "expected email_config.format %s, got %s", tt.expectedEmailFormat, emailFormat)
+			assert.True(t, tt.expectedSyslogServer.Equal(syslogServer), "expected syslog_config.server_id %s, got %s", tt.expectedSyslogServer, syslogServer)
+		})
+	}
+}
@@ -426,7 +426,7 @@ func (r *scopeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 }
 
 // Configure adds the provider-configured client to the resource.
-func (r *scopeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+func (r *scopeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
@@ -437,12 +437,13 @@ func (r *scopeResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	r.client = client.Platform
+	r.client = client.Platform.Client(ctx, &resp.Diagnostics)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *scopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan models.ScopeModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -479,6 +480,7 @@ func (r *scopeResource) Create(ctx context.Context, req resource.CreateRequest,
 // Read refreshes the Terraform state with the latest data.
 func (r *scopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state models.ScopeModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -506,6 +508,7 @@ func (r *scopeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *scopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan models.ScopeModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -540,6 +543,7 @@ func (r *scopeResource) Update(ctx context.Context, req resource.UpdateRequest,
 // Delete deletes the resource and removes it from the Terraform state on success.
 func (r *scopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state models.ScopeModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -586,6 +590,7 @@ func (r *scopeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 // ImportState imports the resource into the Terraform state.
 func (r *scopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -156,7 +156,7 @@ func (r *userGroupResource) Schema(_ context.Context, _ resource.SchemaRequest,
 }
 
 // Configure adds the provider-configured client to the resource.
-func (r *userGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+func (r *userGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
@@ -167,12 +167,13 @@ func (r *userGroupResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	r.client = client.Platform
+	r.client = client.Platform.Client(ctx, &resp.Diagnostics)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *userGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	tflog.Trace(ctx, "Starting userGroupResource.Create()")
 
@@ -229,6 +230,7 @@ func (r *userGroupResource) Create(ctx context.Context, req resource.CreateReque
 // Read refreshes the Terraform state with the latest data.
 func (r *userGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	tflog.Trace(ctx, "Starting userGroupResource.Read()")
 
@@ -271,6 +273,7 @@ func (r *userGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *userGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	tflog.Trace(ctx, "Starting userGroupResource.Update()")
 
@@ -336,6 +339,7 @@ func (r *userGroupResource) Update(ctx context.Context, req resource.UpdateReque
 // Delete deletes the resource and removes it from the Terraform state on success.
 func (r *userGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	tflog.Trace(ctx, "Starting userGroupResource.Delete()")
 
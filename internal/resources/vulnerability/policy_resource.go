@@ -8,23 +8,27 @@ import (
 
 	"github.com/PaloAltoNetworks/cortex-cloud-go/vulnerability"
 	models "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/provider"
+	sharedModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/shared"
 	vulnerabilityModels "github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/models/vulnerability"
 	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &policyResource{}
-	_ resource.ResourceWithConfigure   = &policyResource{}
-	_ resource.ResourceWithImportState = &policyResource{}
+	_ resource.Resource                   = &policyResource{}
+	_ resource.ResourceWithConfigure      = &policyResource{}
+	_ resource.ResourceWithImportState    = &policyResource{}
+	_ resource.ResourceWithValidateConfig = &policyResource{}
 )
 
 // NewPolicyResource is a helper function to simplify the provider implementation.
@@ -34,7 +38,8 @@ func NewPolicyResource() resource.Resource {
 
 // policyResource is the resource implementation.
 type policyResource struct {
-	client *vulnerability.Client
+	client          *vulnerability.Client
+	externalChanges util.ExternalChangeDetection
 }
 
 // Metadata returns the resource type name.
@@ -71,12 +76,36 @@ func (r *policyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 			},
 			"match_criteria": schema.StringAttribute{
-				Description: "The match criteria for the vulnerability policy as a JSON-encoded string. This defines which vulnerabilities the policy applies to.",
-				Required:    true,
+				Description:        "The match criteria for the vulnerability policy as a JSON-encoded string. This defines which vulnerabilities the policy applies to. Exactly one of match_criteria or match_criteria_filter must be configured.",
+				CustomType:         util.NormalizedJSONType{},
+				DeprecationMessage: "Use match_criteria_filter instead. This attribute will be removed in a future major version of the provider.",
+				Optional:           true,
+				Computed:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("match_criteria_filter")),
+				},
+			},
+			"match_criteria_filter": schema.SingleNestedAttribute{
+				Description: vulnerabilityModels.PolicyCriteriaFilterDescription("The match criteria for the vulnerability policy. This defines which vulnerabilities the policy applies to. Exactly one of match_criteria or match_criteria_filter must be configured."),
+				Optional:    true,
+				Computed:    true,
+				Attributes:  sharedModels.RootFilterAttributes,
 			},
 			"exclusion_criteria": schema.StringAttribute{
-				Description: "The exclusion criteria for the vulnerability policy as a JSON-encoded string. This defines which vulnerabilities to exclude from the policy.",
+				Description:        "The exclusion criteria for the vulnerability policy as a JSON-encoded string. This defines which vulnerabilities to exclude from the policy. Conflicts with exclusion_criteria_filter.",
+				CustomType:         util.NormalizedJSONType{},
+				DeprecationMessage: "Use exclusion_criteria_filter instead. This attribute will be removed in a future major version of the provider.",
+				Optional:           true,
+				Computed:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("exclusion_criteria_filter")),
+				},
+			},
+			"exclusion_criteria_filter": schema.SingleNestedAttribute{
+				Description: vulnerabilityModels.PolicyCriteriaFilterDescription("The exclusion criteria for the vulnerability policy. This defines which vulnerabilities to exclude from the policy. Conflicts with exclusion_criteria."),
 				Optional:    true,
+				Computed:    true,
+				Attributes:  sharedModels.RootFilterAttributes,
 			},
 			"action_category": schema.StringAttribute{
 				Description: "The category of actions for the policy.",
@@ -162,12 +191,14 @@ func (r *policyResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	r.client = client.Vulnerability
+	r.client = client.Vulnerability.Client(ctx, &resp.Diagnostics)
+	r.externalChanges = client.ExternalChanges
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan vulnerabilityModels.PolicyModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -208,6 +239,7 @@ func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest,
 // Read refreshes the Terraform state with the latest data.
 func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state vulnerabilityModels.PolicyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -225,11 +257,15 @@ func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Update state with remote data
+	priorModifiedBy, priorModifiedAt := state.ModifiedBy, state.ModifiedTimestamp
 	state.RefreshFromRemote(ctx, &resp.Diagnostics, remote)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Warn if the resource was modified outside of Terraform
+	r.externalChanges.Check(&resp.Diagnostics, "cortexcloud_vulnerability_policy", state.ID.ValueString(), state.ModifiedBy, state.ModifiedTimestamp, priorModifiedBy, priorModifiedAt)
+
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -237,6 +273,7 @@ func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, res
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var plan vulnerabilityModels.PolicyModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -277,6 +314,7 @@ func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest,
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	ctx = util.WithCorrelationID(ctx)
 
 	var state vulnerabilityModels.PolicyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -302,3 +340,14 @@ func (r *policyResource) ImportState(ctx context.Context, req resource.ImportSta
 	// Use the ID from the import request
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// ValidateConfig performs plan-time validation of the resource configuration.
+func (r *policyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config vulnerabilityModels.PolicyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ValidateConfig(ctx, &resp.Diagnostics)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transport
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Limiter limits the rate and the number of concurrent HTTP requests sent
+// through the transports created by NewLimitedTransport. A single Limiter is
+// shared by the SDK clients of every API domain, so that the limits apply to
+// the provider as a whole.
+type Limiter struct {
+	bucket    *tokenBucket
+	semaphore chan struct{}
+}
+
+// NewLimiter returns a Limiter that allows at most requestsPerSecond
+// requests to be started per second and at most maxConcurrent requests to
+// be in flight at once. A value of zero or less disables the respective
+// limit.
+func NewLimiter(requestsPerSecond, maxConcurrent int) *Limiter {
+	limiter := &Limiter{}
+	if requestsPerSecond > 0 {
+		limiter.bucket = newTokenBucket(float64(requestsPerSecond))
+	}
+	if maxConcurrent > 0 {
+		limiter.semaphore = make(chan struct{}, maxConcurrent)
+	}
+
+	return limiter
+}
+
+// acquire blocks until the request is allowed to start by both limits, or
+// until ctx is done.
+func (l *Limiter) acquire(ctx context.Context) error {
+	if l.semaphore != nil {
+		select {
+		case l.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if l.bucket != nil {
+		if err := l.bucket.wait(ctx); err != nil {
+			l.release()
+			return err
+		}
+	}
+
+	return nil
+}
+
+// release frees the concurrency slot held by a request.
+func (l *Limiter) release() {
+	if l.semaphore != nil {
+		<-l.semaphore
+	}
+}
+
+// tokenBucket is a token bucket refilled at a constant rate, with a
+// capacity of one second's worth of tokens.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(1, rate)
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// wait takes a token from the bucket, blocking until the token is available
+// or ctx is done. Tokens are reserved in order, so waiting callers are
+// served on a first come, first served basis.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Return the reserved token
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// NewLimitedTransport returns an http.RoundTripper that sends requests
+// using next once they are allowed by limiter. A request holds its
+// concurrency slot until its response body is closed.
+func NewLimitedTransport(next http.RoundTripper, limiter *Limiter) http.RoundTripper {
+	return &limitedTransport{
+		next:    next,
+		limiter: limiter,
+	}
+}
+
+type limitedTransport struct {
+	next    http.RoundTripper
+	limiter *Limiter
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.acquire(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.limiter.release()
+		return nil, err
+	}
+
+	resp.Body = &releasingBody{
+		ReadCloser: resp.Body,
+		release:    t.limiter.release,
+	}
+
+	return resp, nil
+}
+
+// releasingBody releases the concurrency slot held by a request when the
+// response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+// Close implements io.Closer.
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
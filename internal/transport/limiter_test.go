@@ -0,0 +1,158 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newInFlightServer returns a test server that records the maximum number
+// of requests it handled concurrently. Each request is held for the given
+// duration before a response is written.
+func newInFlightServer(t *testing.T, hold time.Duration, maxInFlight *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	var inFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+
+		time.Sleep(hold)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// doRequest sends a GET request to url using client and closes the
+// response body.
+func doRequest(ctx context.Context, client *http.Client, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, err = io.Copy(io.Discard, resp.Body)
+	return err
+}
+
+func TestLimitedTransport_MaxConcurrentRequests(t *testing.T) {
+	const maxConcurrent = 3
+
+	var maxInFlight atomic.Int32
+	server := newInFlightServer(t, 50*time.Millisecond, &maxInFlight)
+
+	limiter := NewLimiter(0, maxConcurrent)
+	client := &http.Client{Transport: NewLimitedTransport(http.DefaultTransport, limiter)}
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, doRequest(context.Background(), client, server.URL))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(maxConcurrent), maxInFlight.Load())
+}
+
+func TestLimitedTransport_SharedLimiter(t *testing.T) {
+	var maxInFlight atomic.Int32
+	server := newInFlightServer(t, 50*time.Millisecond, &maxInFlight)
+
+	// Transports created from the same limiter, such as those of the SDK
+	// clients of different API domains, share the limit
+	limiter := NewLimiter(0, 2)
+	clients := []*http.Client{
+		{Transport: NewLimitedTransport(http.DefaultTransport, limiter)},
+		{Transport: NewLimitedTransport(http.DefaultTransport, limiter)},
+	}
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, doRequest(context.Background(), clients[i%2], server.URL))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), maxInFlight.Load())
+}
+
+func TestLimitedTransport_MaxRequestsPerSecond(t *testing.T) {
+	const requestsPerSecond = 20
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	limiter := NewLimiter(requestsPerSecond, 0)
+	client := &http.Client{Transport: NewLimitedTransport(http.DefaultTransport, limiter)}
+
+	// The first second's worth of requests is allowed immediately, and the
+	// remaining requests are spread out at the configured rate
+	start := time.Now()
+	for range requestsPerSecond + requestsPerSecond/2 {
+		require.NoError(t, doRequest(context.Background(), client, server.URL))
+	}
+	elapsed := time.Since(start)
+
+	assert.Equal(t, int32(requestsPerSecond+requestsPerSecond/2), requests.Load())
+	assert.GreaterOrEqual(t, elapsed, 450*time.Millisecond)
+}
+
+func TestLimitedTransport_ContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	limiter := NewLimiter(0, 1)
+	client := &http.Client{Transport: NewLimitedTransport(http.DefaultTransport, limiter)}
+
+	// Hold the only concurrency slot by leaving the response body open
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, doRequest(ctx, client, server.URL), context.DeadlineExceeded)
+
+	// Closing the body releases the slot
+	require.NoError(t, resp.Body.Close())
+	assert.NoError(t, doRequest(context.Background(), client, server.URL))
+}
@@ -0,0 +1,366 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// TraceSubsystem is the name of the log subsystem that trace records are
+	// written to when no trace file is configured.
+	TraceSubsystem = "http_trace"
+
+	// redactedValue replaces the values of sensitive headers and attributes
+	// in trace records.
+	redactedValue = "REDACTED"
+
+	// maxTracedBodySize is the size above which request and response bodies
+	// are omitted from trace records.
+	maxTracedBodySize = 64 * 1024
+)
+
+// sensitiveHeaders contains the canonical names of the headers whose values
+// are redacted in trace records.
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Xdr-Auth-Id":       true,
+}
+
+// sensitiveAttributeNames contains the substrings that identify the JSON
+// attributes whose values are redacted in trace records. Attribute names
+// are compared in lowercase with underscores and hyphens removed, so that
+// e.g. "service_provider_private_key" and "privateKey" both match
+// "privatekey".
+var sensitiveAttributeNames = []string{
+	"apikey",
+	"authorization",
+	"clientsecret",
+	"credential",
+	"passphrase",
+	"password",
+	"privatekey",
+	"secret",
+	"token",
+}
+
+// TraceRecord is a single HTTP request and its response, as written to the
+// HTTP trace.
+type TraceRecord struct {
+	Time            string            `json:"time"`
+	CorrelationID   string            `json:"correlation_id"`
+	Method          string            `json:"method"`
+	Path            string            `json:"path"`
+	Status          int               `json:"status,omitempty"`
+	LatencyMS       int64             `json:"latency_ms"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	RequestBody     any               `json:"request_body,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    any               `json:"response_body,omitempty"`
+	Error           string            `json:"error,omitempty"`
+}
+
+// TraceSink writes trace records.
+type TraceSink interface {
+	Write(ctx context.Context, record []byte)
+}
+
+// fileTraceSinks holds the file sinks opened by the current provider
+// process, keyed by the absolute path of their file, so that provider
+// configurations sharing a trace file (e.g. aliased providers) write to it
+// through a single file handle and lock.
+var fileTraceSinks = struct {
+	sync.Mutex
+	sinks map[string]*fileTraceSink
+}{
+	sinks: map[string]*fileTraceSink{},
+}
+
+// NewFileTraceSink returns a TraceSink that appends each record as a line to
+// the file at the given path, creating the file if it does not exist. The
+// sink is shared with every other caller using the same path until
+// CloseTraceSinks is called.
+func NewFileTraceSink(filePath string) (TraceSink, error) {
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	fileTraceSinks.Lock()
+	defer fileTraceSinks.Unlock()
+
+	if sink, ok := fileTraceSinks.sinks[absPath]; ok {
+		return sink, nil
+	}
+
+	file, err := os.OpenFile(absPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	sink := &fileTraceSink{file: file}
+	fileTraceSinks.sinks[absPath] = sink
+
+	return sink, nil
+}
+
+// CloseTraceSinks closes the files of every sink returned by
+// NewFileTraceSink. It is called when the provider process shuts down.
+func CloseTraceSinks() error {
+	fileTraceSinks.Lock()
+	defer fileTraceSinks.Unlock()
+
+	var errs []error
+	for absPath, sink := range fileTraceSinks.sinks {
+		if err := sink.close(); err != nil {
+			errs = append(errs, fmt.Errorf("error closing HTTP trace file %s: %w", absPath, err))
+		}
+		delete(fileTraceSinks.sinks, absPath)
+	}
+
+	return errors.Join(errs...)
+}
+
+type fileTraceSink struct {
+	mu     sync.Mutex
+	file   *os.File
+	closed bool
+}
+
+// Write implements TraceSink.
+func (s *fileTraceSink) Write(ctx context.Context, record []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	if _, err := s.file.Write(append(record, '\n')); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error writing HTTP trace record: %s", err.Error()))
+	}
+}
+
+// close closes the file of the sink. Records written after the sink is
+// closed are discarded.
+func (s *fileTraceSink) close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+
+	return s.file.Close()
+}
+
+// NewLogTraceSink returns a TraceSink that writes each record to the
+// TraceSubsystem log subsystem at the debug level.
+func NewLogTraceSink() TraceSink {
+	return logTraceSink{}
+}
+
+type logTraceSink struct{}
+
+// Write implements TraceSink.
+func (logTraceSink) Write(ctx context.Context, record []byte) {
+	ctx = tflog.NewSubsystem(ctx, TraceSubsystem)
+	tflog.SubsystemDebug(ctx, TraceSubsystem, string(record))
+}
+
+// NewTraceTransport returns an http.RoundTripper that sends requests using
+// next and writes a redacted TraceRecord of each request and its response to
+// sink. Requests are attributed to the correlation ID of their context, or
+// to a new correlation ID if their context does not contain one.
+func NewTraceTransport(next http.RoundTripper, sink TraceSink) http.RoundTripper {
+	return &traceTransport{
+		next: next,
+		sink: sink,
+	}
+}
+
+type traceTransport struct {
+	next http.RoundTripper
+	sink TraceSink
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	correlationID, ok := util.CorrelationID(ctx)
+	if !ok {
+		correlationID = util.NewCorrelationID()
+	}
+
+	record := TraceRecord{
+		CorrelationID:  correlationID,
+		Method:         req.Method,
+		Path:           req.URL.Path,
+		RequestHeaders: redactHeaders(req.Header),
+	}
+
+	requestBody, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	record.RequestBody = redactBody(requestBody, req.Header.Get("Content-Type"))
+
+	start := time.Now()
+	record.Time = start.UTC().Format(time.RFC3339Nano)
+
+	resp, err := t.next.RoundTrip(req)
+	record.LatencyMS = time.Since(start).Milliseconds()
+	if err != nil {
+		record.Error = err.Error()
+		t.write(ctx, record)
+		return nil, err
+	}
+
+	record.Status = resp.StatusCode
+	record.ResponseHeaders = redactHeaders(resp.Header)
+
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		record.Error = err.Error()
+		t.write(ctx, record)
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	record.ResponseBody = redactBody(responseBody, resp.Header.Get("Content-Type"))
+
+	t.write(ctx, record)
+
+	return resp, nil
+}
+
+func (t *traceTransport) write(ctx context.Context, record TraceRecord) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error encoding HTTP trace record: %s", err.Error()))
+		return
+	}
+
+	t.sink.Write(ctx, line)
+}
+
+// peekRequestBody returns the body of req, leaving it readable for the
+// next transport.
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+
+	return data, nil
+}
+
+// redactHeaders returns the first value of each header, with the values of
+// sensitive headers redacted.
+func redactHeaders(header http.Header) map[string]string {
+	if len(header) == 0 {
+		return nil
+	}
+
+	redacted := make(map[string]string, len(header))
+	for name := range header {
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			redacted[name] = redactedValue
+			continue
+		}
+		redacted[name] = header.Get(name)
+	}
+
+	return redacted
+}
+
+// redactBody returns the decoded JSON body with the values of sensitive
+// attributes redacted. Bodies that are not JSON or that exceed
+// maxTracedBodySize are replaced with a description of their size, since
+// their contents cannot be redacted reliably.
+func redactBody(body []byte, contentType string) any {
+	if len(body) == 0 {
+		return nil
+	}
+	if len(body) > maxTracedBodySize {
+		return fmt.Sprintf("<%d bytes omitted>", len(body))
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(contentType); contentType != "" && (err != nil || (mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json"))) {
+		return fmt.Sprintf("<%d bytes of %s omitted>", len(body), contentType)
+	}
+
+	var decoded any
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return fmt.Sprintf("<%d bytes omitted>", len(body))
+	}
+
+	return redactValue(decoded)
+}
+
+// redactValue redacts the values of the sensitive attributes of the given
+// decoded JSON value and its nested objects.
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			if isSensitiveAttribute(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(nested)
+		}
+	case []any:
+		for i, nested := range v {
+			v[i] = redactValue(nested)
+		}
+	}
+
+	return value
+}
+
+// isSensitiveAttribute returns true if the JSON attribute with the given
+// name contains a secret.
+func isSensitiveAttribute(name string) bool {
+	normalized := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+	for _, sensitive := range sensitiveAttributeNames {
+		if strings.Contains(normalized, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,42 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// correlationIDKey is the context key of the correlation ID.
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a copy of ctx containing a new correlation ID,
+// which identifies the HTTP requests sent during a single resource, data
+// source or ephemeral resource operation in the HTTP trace. The ID is also
+// added to the fields of the log entries written using the returned context.
+func WithCorrelationID(ctx context.Context) context.Context {
+	id := NewCorrelationID()
+	ctx = context.WithValue(ctx, correlationIDKey{}, id)
+
+	return tflog.SetField(ctx, "correlation_id", id)
+}
+
+// CorrelationID returns the correlation ID contained in ctx, if any.
+func CorrelationID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(correlationIDKey{}).(string)
+	return id, ok
+}
+
+// NewCorrelationID returns a random correlation ID.
+func NewCorrelationID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+
+	return hex.EncodeToString(b)
+}
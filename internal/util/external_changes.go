@@ -0,0 +1,87 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ExternalChangeDetection configures the warnings emitted when a resource
+// managed by Terraform was last modified by someone other than the API key
+// used by the provider.
+type ExternalChangeDetection struct {
+	// Enabled is set by the provider's `detect_external_changes` attribute.
+	Enabled bool
+
+	// Identity is the provider's `api_key_identity`, i.e. the value that the
+	// API records as the modifier of objects changed using the API key. It is
+	// required when Enabled is true.
+	Identity string
+}
+
+// Check adds a warning to diags if modifiedBy, as returned by the API, does
+// not match the identity of the API key. modifiedAt is the Int64
+// (milliseconds since the epoch) or String modification time.
+//
+// priorModifiedBy and priorModifiedAt are the values stored in the prior
+// state. No warning is added if they match the values returned by the API,
+// so that each external change is only reported by the first refresh that
+// observes it rather than by every subsequent refresh.
+func (d ExternalChangeDetection) Check(diags *diag.Diagnostics, resourceType, id string, modifiedBy types.String, modifiedAt attr.Value, priorModifiedBy types.String, priorModifiedAt attr.Value) {
+	if !d.Enabled || d.Identity == "" || modifiedBy.IsNull() || modifiedBy.IsUnknown() || modifiedBy.ValueString() == "" {
+		return
+	}
+
+	if modifiedBy.ValueString() == d.Identity {
+		return
+	}
+
+	// The change was already reported when it was first refreshed
+	if modifiedBy.Equal(priorModifiedBy) && modificationTimeEqual(modifiedAt, priorModifiedAt) {
+		return
+	}
+
+	diags.AddWarning(
+		"Resource Modified Outside of Terraform",
+		fmt.Sprintf("%s \"%s\" was last modified by \"%s\" at %s, which does not match the identity of the API key used by Terraform (\"%s\"). "+
+			"Review the plan for changes made outside of Terraform and either update the configuration to include them or apply it to revert them.",
+			resourceType, id, modifiedBy.ValueString(), formatModificationTime(modifiedAt), d.Identity),
+	)
+}
+
+// modificationTimeEqual returns true if the given modification times are
+// equal. Missing values are only equal to each other.
+func modificationTimeEqual(a, b attr.Value) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.Equal(b)
+}
+
+// formatModificationTime returns the given modification time as an RFC 3339
+// timestamp if it is an Int64 containing milliseconds since the epoch, or
+// as-is if it is a String.
+func formatModificationTime(value attr.Value) string {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return "an unknown time"
+	}
+
+	switch v := value.(type) {
+	case types.Int64:
+		return time.UnixMilli(v.ValueInt64()).UTC().Format(time.RFC3339)
+	case types.String:
+		if v.ValueString() == "" {
+			return "an unknown time"
+		}
+		return v.ValueString()
+	}
+
+	return value.String()
+}
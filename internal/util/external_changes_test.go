@@ -0,0 +1,105 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalChangeDetection_Check(t *testing.T) {
+	tests := []struct {
+		name           string
+		detection      ExternalChangeDetection
+		modifiedBy     types.String
+		modifiedAt     attr.Value
+		priorBy        types.String
+		priorAt        attr.Value
+		expectWarning  bool
+		expectedDetail string
+	}{
+		{
+			name:       "disabled",
+			detection:  ExternalChangeDetection{Identity: "terraform"},
+			modifiedBy: types.StringValue("jane.doe@example.com"),
+			modifiedAt: types.Int64Value(1678886400000),
+		},
+		{
+			name:       "modified by configured identity",
+			detection:  ExternalChangeDetection{Enabled: true, Identity: "terraform"},
+			modifiedBy: types.StringValue("terraform"),
+			modifiedAt: types.Int64Value(1678886400000),
+		},
+		{
+			name:           "modified by other than configured identity",
+			detection:      ExternalChangeDetection{Enabled: true, Identity: "terraform"},
+			modifiedBy:     types.StringValue("jane.doe@example.com"),
+			modifiedAt:     types.Int64Value(1678886400000),
+			expectWarning:  true,
+			expectedDetail: `cortexcloud_cloudsec_rule "rule-1" was last modified by "jane.doe@example.com" at 2023-03-15T13:20:00Z`,
+		},
+		{
+			name:       "external change already in prior state",
+			detection:  ExternalChangeDetection{Enabled: true, Identity: "terraform"},
+			modifiedBy: types.StringValue("jane.doe@example.com"),
+			modifiedAt: types.Int64Value(1678886400000),
+			priorBy:    types.StringValue("jane.doe@example.com"),
+			priorAt:    types.Int64Value(1678886400000),
+		},
+		{
+			name:           "new external change by the same modifier",
+			detection:      ExternalChangeDetection{Enabled: true, Identity: "terraform"},
+			modifiedBy:     types.StringValue("jane.doe@example.com"),
+			modifiedAt:     types.Int64Value(1678972800000),
+			priorBy:        types.StringValue("jane.doe@example.com"),
+			priorAt:        types.Int64Value(1678886400000),
+			expectWarning:  true,
+			expectedDetail: `cortexcloud_cloudsec_rule "rule-1" was last modified by "jane.doe@example.com" at 2023-03-16T13:20:00Z`,
+		},
+		{
+			name:           "external change after a change by Terraform",
+			detection:      ExternalChangeDetection{Enabled: true, Identity: "terraform"},
+			modifiedBy:     types.StringValue("jane.doe@example.com"),
+			modifiedAt:     types.StringValue("2023-03-16T13:20:00Z"),
+			priorBy:        types.StringValue("terraform"),
+			priorAt:        types.StringValue("2023-03-15T13:20:00Z"),
+			expectWarning:  true,
+			expectedDetail: `cortexcloud_cloudsec_rule "rule-1" was last modified by "jane.doe@example.com" at 2023-03-16T13:20:00Z`,
+		},
+		{
+			name:       "no configured identity",
+			detection:  ExternalChangeDetection{Enabled: true},
+			modifiedBy: types.StringValue("jane.doe@example.com"),
+			modifiedAt: types.Int64Value(1678886400000),
+		},
+		{
+			name:       "modifier not returned",
+			detection:  ExternalChangeDetection{Enabled: true, Identity: "terraform"},
+			modifiedBy: types.StringNull(),
+			modifiedAt: types.Int64Null(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			tt.detection.Check(&diags, "cortexcloud_cloudsec_rule", "rule-1", tt.modifiedBy, tt.modifiedAt, tt.priorBy, tt.priorAt)
+
+			require.False(t, diags.HasError())
+			if !tt.expectWarning {
+				assert.Empty(t, diags.Warnings())
+				return
+			}
+
+			require.Len(t, diags.Warnings(), 1)
+			assert.Equal(t, "Resource Modified Outside of Terraform", diags.Warnings()[0].Summary())
+			assert.Contains(t, diags.Warnings()[0].Detail(), tt.expectedDetail)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// labelsValue is implemented by the list and set values used for the
+// `labels` attributes of the resources that support the provider's
+// `default_labels`.
+type labelsValue interface {
+	IsNull() bool
+	IsUnknown() bool
+	ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics
+}
+
+// labelsAttribute is the type of the `labels` attribute of a resource that
+// supports the provider's `default_labels`.
+type labelsAttribute interface {
+	types.Set | types.List
+	labelsValue
+}
+
+// labelsFromValue returns the elements of the given labels value, or nil if
+// the value is null or unknown.
+func labelsFromValue(ctx context.Context, value labelsValue, diags *diag.Diagnostics) []string {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	var labels []string
+	diags.Append(value.ElementsAs(ctx, &labels, false)...)
+
+	return labels
+}
+
+// LabelsSetValue returns the given labels as a set value, or a null set if
+// there are no labels.
+func LabelsSetValue(ctx context.Context, labels []string, diags *diag.Diagnostics) types.Set {
+	if len(labels) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	value, d := types.SetValueFrom(ctx, types.StringType, labels)
+	diags.Append(d...)
+
+	return value
+}
+
+// labelsListValue returns the given labels as a list value, or a null list
+// if there are no labels.
+func labelsListValue(ctx context.Context, labels []string, diags *diag.Diagnostics) types.List {
+	if len(labels) == 0 {
+		return types.ListNull(types.StringType)
+	}
+
+	value, d := types.ListValueFrom(ctx, types.StringType, labels)
+	diags.Append(d...)
+
+	return value
+}
+
+// MergeLabels returns labels followed by each of defaultLabels that is not
+// already present in labels.
+func MergeLabels(labels, defaultLabels []string) []string {
+	merged := slices.Clone(labels)
+	for _, label := range defaultLabels {
+		if !slices.Contains(merged, label) {
+			merged = append(merged, label)
+		}
+	}
+
+	return merged
+}
+
+// EffectiveLabels returns the union of the configured labels and the
+// provider's default labels as a set. An unknown set is returned if the
+// configured labels are unknown, and a null set if the union is empty.
+func EffectiveLabels(ctx context.Context, labels labelsValue, defaultLabels []string, diags *diag.Diagnostics) types.Set {
+	if labels != nil && labels.IsUnknown() {
+		return types.SetUnknown(types.StringType)
+	}
+
+	return LabelsSetValue(ctx, MergeLabels(labelsFromValue(ctx, labels, diags), defaultLabels), diags)
+}
+
+// RequestLabels returns the labels to send to the API. The effective labels,
+// which include the provider's default labels, are used when they are known,
+// and the configured labels otherwise. The returned bool is false if neither
+// value contains any labels.
+func RequestLabels(ctx context.Context, labels labelsValue, effectiveLabels types.Set, diags *diag.Diagnostics) ([]string, bool) {
+	if !effectiveLabels.IsNull() && !effectiveLabels.IsUnknown() {
+		return labelsFromValue(ctx, effectiveLabels, diags), true
+	}
+	if labels.IsNull() || labels.IsUnknown() {
+		return nil, false
+	}
+
+	return labelsFromValue(ctx, labels, diags), true
+}
+
+// WithoutDefaultLabels returns the given labels, as returned by the API,
+// without the provider's default labels. Default labels that are also part
+// of the configured labels are kept so that they are not reported as drift.
+func WithoutDefaultLabels(ctx context.Context, labels, configured labelsValue, defaultLabels []string, diags *diag.Diagnostics) []string {
+	configuredLabels := labelsFromValue(ctx, configured, diags)
+
+	var result []string
+	for _, label := range labelsFromValue(ctx, labels, diags) {
+		if slices.Contains(defaultLabels, label) && !slices.Contains(configuredLabels, label) {
+			continue
+		}
+		result = append(result, label)
+	}
+
+	return result
+}
+
+// SuppressDefaultLabels returns the given labels, as returned by the API,
+// without the provider's default labels unless they are also part of the
+// configured labels, so that they are only reported in `effective_labels`.
+func SuppressDefaultLabels[T labelsAttribute](ctx context.Context, labels, configured T, defaultLabels []string, diags *diag.Diagnostics) T {
+	if len(defaultLabels) == 0 {
+		return labels
+	}
+
+	remaining := WithoutDefaultLabels(ctx, labels, configured, defaultLabels, diags)
+
+	var value any
+	switch any(labels).(type) {
+	case types.List:
+		value = labelsListValue(ctx, remaining, diags)
+	default:
+		value = LabelsSetValue(ctx, remaining, diags)
+	}
+
+	return value.(T)
+}
+
+// ModifyPlanLabels sets the planned `effective_labels` of a resource to the
+// union of its planned `labels` and the provider's default labels. Nothing
+// is planned when the resource is being destroyed.
+func ModifyPlanLabels[T labelsAttribute](ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, defaultLabels []string) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var labels T
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("labels"), &labels)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	effectiveLabels := EffectiveLabels(ctx, labels, defaultLabels, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_labels"), effectiveLabels)...)
+}
@@ -0,0 +1,212 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stringSet(labels ...string) types.Set {
+	elements := make([]attr.Value, len(labels))
+	for i, label := range labels {
+		elements[i] = types.StringValue(label)
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func stringList(labels ...string) types.List {
+	elements := make([]attr.Value, len(labels))
+	for i, label := range labels {
+		elements[i] = types.StringValue(label)
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func TestMergeLabels(t *testing.T) {
+	tests := []struct {
+		name          string
+		labels        []string
+		defaultLabels []string
+		expected      []string
+	}{
+		{
+			name:          "no default labels",
+			labels:        []string{"a", "b"},
+			defaultLabels: nil,
+			expected:      []string{"a", "b"},
+		},
+		{
+			name:          "no configured labels",
+			labels:        nil,
+			defaultLabels: []string{"managed-by:terraform"},
+			expected:      []string{"managed-by:terraform"},
+		},
+		{
+			name:          "overlapping labels are not duplicated",
+			labels:        []string{"team:x", "managed-by:terraform"},
+			defaultLabels: []string{"managed-by:terraform", "env:prod"},
+			expected:      []string{"team:x", "managed-by:terraform", "env:prod"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MergeLabels(tt.labels, tt.defaultLabels))
+		})
+	}
+}
+
+func TestEffectiveLabels(t *testing.T) {
+	ctx := context.Background()
+	defaultLabels := []string{"managed-by:terraform"}
+
+	t.Run("set labels are merged with default labels", func(t *testing.T) {
+		var diags diag.Diagnostics
+		result := EffectiveLabels(ctx, stringSet("team:x"), defaultLabels, &diags)
+		require.False(t, diags.HasError())
+		assert.True(t, result.Equal(stringSet("team:x", "managed-by:terraform")), "got %s", result)
+	})
+
+	t.Run("list labels are merged with default labels", func(t *testing.T) {
+		var diags diag.Diagnostics
+		result := EffectiveLabels(ctx, stringList("team:x"), defaultLabels, &diags)
+		require.False(t, diags.HasError())
+		assert.True(t, result.Equal(stringSet("team:x", "managed-by:terraform")), "got %s", result)
+	})
+
+	t.Run("null labels result in default labels", func(t *testing.T) {
+		var diags diag.Diagnostics
+		result := EffectiveLabels(ctx, types.SetNull(types.StringType), defaultLabels, &diags)
+		require.False(t, diags.HasError())
+		assert.True(t, result.Equal(stringSet("managed-by:terraform")), "got %s", result)
+	})
+
+	t.Run("unknown labels result in unknown effective labels", func(t *testing.T) {
+		var diags diag.Diagnostics
+		result := EffectiveLabels(ctx, types.SetUnknown(types.StringType), defaultLabels, &diags)
+		require.False(t, diags.HasError())
+		assert.True(t, result.IsUnknown())
+	})
+
+	t.Run("no labels result in null effective labels", func(t *testing.T) {
+		var diags diag.Diagnostics
+		result := EffectiveLabels(ctx, types.SetNull(types.StringType), nil, &diags)
+		require.False(t, diags.HasError())
+		assert.True(t, result.IsNull())
+	})
+}
+
+func TestRequestLabels(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("effective labels are preferred", func(t *testing.T) {
+		var diags diag.Diagnostics
+		labels, ok := RequestLabels(ctx, stringSet("team:x"), stringSet("team:x", "managed-by:terraform"), &diags)
+		require.False(t, diags.HasError())
+		assert.True(t, ok)
+		assert.ElementsMatch(t, []string{"team:x", "managed-by:terraform"}, labels)
+	})
+
+	t.Run("configured labels are used when effective labels are not set", func(t *testing.T) {
+		var diags diag.Diagnostics
+		labels, ok := RequestLabels(ctx, stringList("team:x"), types.SetNull(types.StringType), &diags)
+		require.False(t, diags.HasError())
+		assert.True(t, ok)
+		assert.Equal(t, []string{"team:x"}, labels)
+	})
+
+	t.Run("no labels", func(t *testing.T) {
+		var diags diag.Diagnostics
+		_, ok := RequestLabels(ctx, types.SetNull(types.StringType), types.SetNull(types.StringType), &diags)
+		require.False(t, diags.HasError())
+		assert.False(t, ok)
+	})
+}
+
+func TestWithoutDefaultLabels(t *testing.T) {
+	ctx := context.Background()
+	defaultLabels := []string{"managed-by:terraform", "team:x"}
+
+	tests := []struct {
+		name       string
+		remote     types.Set
+		configured types.Set
+		expected   []string
+	}{
+		{
+			name:       "default labels are removed",
+			remote:     stringSet("custom", "managed-by:terraform", "team:x"),
+			configured: stringSet("custom"),
+			expected:   []string{"custom"},
+		},
+		{
+			name:       "configured default labels are kept",
+			remote:     stringSet("custom", "managed-by:terraform", "team:x"),
+			configured: stringSet("custom", "team:x"),
+			expected:   []string{"custom", "team:x"},
+		},
+		{
+			name:       "labels added outside of Terraform are kept",
+			remote:     stringSet("managed-by:terraform", "added-in-console"),
+			configured: types.SetNull(types.StringType),
+			expected:   []string{"added-in-console"},
+		},
+		{
+			name:       "only default labels",
+			remote:     stringSet("managed-by:terraform", "team:x"),
+			configured: types.SetNull(types.StringType),
+			expected:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			result := WithoutDefaultLabels(ctx, tt.remote, tt.configured, defaultLabels, &diags)
+			require.False(t, diags.HasError())
+			assert.ElementsMatch(t, tt.expected, result)
+		})
+	}
+}
+
+func TestSuppressDefaultLabels(t *testing.T) {
+	ctx := context.Background()
+	defaultLabels := []string{"managed-by:terraform"}
+
+	t.Run("set", func(t *testing.T) {
+		var diags diag.Diagnostics
+		result := SuppressDefaultLabels(ctx, stringSet("custom", "managed-by:terraform"), stringSet("custom"), defaultLabels, &diags)
+		require.False(t, diags.HasError())
+		assert.Equal(t, stringSet("custom"), result)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		var diags diag.Diagnostics
+		result := SuppressDefaultLabels(ctx, stringList("custom", "managed-by:terraform"), stringList("custom"), defaultLabels, &diags)
+		require.False(t, diags.HasError())
+		assert.Equal(t, stringList("custom"), result)
+	})
+
+	t.Run("only default labels", func(t *testing.T) {
+		var diags diag.Diagnostics
+		result := SuppressDefaultLabels(ctx, stringList("managed-by:terraform"), types.ListNull(types.StringType), defaultLabels, &diags)
+		require.False(t, diags.HasError())
+		assert.True(t, result.IsNull())
+	})
+
+	t.Run("no default labels", func(t *testing.T) {
+		var diags diag.Diagnostics
+		labels := stringSet("custom", "managed-by:terraform")
+		result := SuppressDefaultLabels(ctx, labels, stringSet("custom"), nil, &diags)
+		require.False(t, diags.HasError())
+		assert.Equal(t, labels, result)
+	})
+}
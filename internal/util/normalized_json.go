@@ -0,0 +1,255 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = NormalizedJSONType{}
+	_ basetypes.StringValuableWithSemanticEquals = NormalizedJSON{}
+)
+
+// NormalizedJSONType is a string type for attributes containing a
+// JSON-encoded document. Values of this type are compared semantically, so
+// that differences in key order, whitespace or number formatting between the
+// configuration and the document returned by the API do not produce a diff.
+// This includes the search values of filters, which the API may return as
+// numbers although they were sent as strings.
+type NormalizedJSONType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable representation of the type.
+func (t NormalizedJSONType) String() string {
+	return "util.NormalizedJSONType"
+}
+
+// ValueType returns the value type of this type.
+func (t NormalizedJSONType) ValueType(ctx context.Context) attr.Value {
+	return NormalizedJSON{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t NormalizedJSONType) Equal(o attr.Type) bool {
+	other, ok := o.(NormalizedJSONType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString converts a string value to a NormalizedJSON value.
+func (t NormalizedJSONType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return NormalizedJSON{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a Terraform value to a NormalizedJSON value.
+func (t NormalizedJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// NormalizedJSON is the value of a NormalizedJSONType attribute.
+type NormalizedJSON struct {
+	basetypes.StringValue
+}
+
+// NewNormalizedJSONNull returns a null NormalizedJSON value.
+func NewNormalizedJSONNull() NormalizedJSON {
+	return NormalizedJSON{StringValue: basetypes.NewStringNull()}
+}
+
+// NewNormalizedJSONUnknown returns an unknown NormalizedJSON value.
+func NewNormalizedJSONUnknown() NormalizedJSON {
+	return NormalizedJSON{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewNormalizedJSONValue returns a known NormalizedJSON value.
+func NewNormalizedJSONValue(value string) NormalizedJSON {
+	return NormalizedJSON{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Type returns the type of the value.
+func (v NormalizedJSON) Type(ctx context.Context) attr.Type {
+	return NormalizedJSONType{}
+}
+
+// Equal returns true if the given value is equal, including its
+// formatting. Use StringSemanticEquals to compare the JSON documents.
+func (v NormalizedJSON) Equal(o attr.Value) bool {
+	other, ok := o.(NormalizedJSON)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given value contains an
+// equivalent JSON document. If either value is not valid JSON, the values
+// are compared as plain strings.
+//
+// The framework uses this to keep the prior value whenever the value
+// returned by the provider is semantically equal.
+func (v NormalizedJSON) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(NormalizedJSON)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+		return false, diags
+	}
+
+	return JSONStringsEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// JSONStringsEqual returns true if a and b contain equivalent JSON
+// documents. Object keys are compared regardless of order, insignificant
+// whitespace is ignored and numbers are compared by value, so 1, 1.0 and
+// 1e0 are equal. SEARCH_VALUE fields are also compared by value if both
+// contain a number or a string representing a number, so "7.0" and 7 are
+// equal. Array elements are compared in order. If either string is not valid
+// JSON, the strings are compared verbatim.
+func JSONStringsEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	aValue, err := decodeJSON(a)
+	if err != nil {
+		return false
+	}
+	bValue, err := decodeJSON(b)
+	if err != nil {
+		return false
+	}
+
+	return jsonValuesEqual(aValue, bValue)
+}
+
+// decodeJSON decodes a single JSON document, preserving the textual
+// representation of numbers.
+func decodeJSON(s string) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(s)))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after JSON document")
+	}
+
+	return value, nil
+}
+
+// jsonValuesEqual recursively compares two values decoded by decodeJSON.
+func jsonValuesEqual(a, b any) bool {
+	switch aValue := a.(type) {
+	case map[string]any:
+		bValue, ok := b.(map[string]any)
+		if !ok || len(aValue) != len(bValue) {
+			return false
+		}
+		for key, aChild := range aValue {
+			bChild, exists := bValue[key]
+			if !exists {
+				return false
+			}
+			if key == "SEARCH_VALUE" && numericValuesEqual(aChild, bChild) {
+				continue
+			}
+			if !jsonValuesEqual(aChild, bChild) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		bValue, ok := b.([]any)
+		if !ok || len(aValue) != len(bValue) {
+			return false
+		}
+		for i := range aValue {
+			if !jsonValuesEqual(aValue[i], bValue[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		bValue, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		aRat, aOk := new(big.Rat).SetString(aValue.String())
+		bRat, bOk := new(big.Rat).SetString(bValue.String())
+		if !aOk || !bOk {
+			return aValue == bValue
+		}
+		return aRat.Cmp(bRat) == 0
+	default:
+		// Strings, booleans and null
+		return a == b
+	}
+}
+
+// numericValuesEqual returns true if a and b are both numbers or strings
+// representing numbers, and have the same value.
+func numericValuesEqual(a, b any) bool {
+	aRat, aOk := numericValue(a)
+	bRat, bOk := numericValue(b)
+
+	return aOk && bOk && aRat.Cmp(bRat) == 0
+}
+
+// numericValue returns the value of a number, or of a string representing
+// a number, decoded by decodeJSON.
+func numericValue(v any) (*big.Rat, bool) {
+	switch value := v.(type) {
+	case json.Number:
+		return new(big.Rat).SetString(value.String())
+	case string:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, false
+		}
+		return new(big.Rat).SetString(value)
+	default:
+		return nil, false
+	}
+}
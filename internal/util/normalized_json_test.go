@@ -0,0 +1,227 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestJSONStringsEqual(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     string
+		b     string
+		equal bool
+	}{
+		{
+			name:  "identical",
+			a:     `{"a":"1","b":"2"}`,
+			b:     `{"a":"1","b":"2"}`,
+			equal: true,
+		},
+		{
+			name:  "different key order",
+			a:     `{"b":"2","a":"1"}`,
+			b:     `{"a":"1","b":"2"}`,
+			equal: true,
+		},
+		{
+			name:  "different whitespace",
+			a:     "{\n  \"AND\": [\n    {\"SEARCH_FIELD\": \"a\"}\n  ]\n}\n",
+			b:     `{"AND":[{"SEARCH_FIELD":"a"}]}`,
+			equal: true,
+		},
+		{
+			name:  "nested objects with different key order",
+			a:     `{"AND":[{"SEARCH_TYPE":"EQ","SEARCH_FIELD":"a"}]}`,
+			b:     `{"AND":[{"SEARCH_FIELD":"a","SEARCH_TYPE":"EQ"}]}`,
+			equal: true,
+		},
+		{
+			name:  "different number formatting",
+			a:     `{"a":1,"b":1.50,"c":100}`,
+			b:     `{"a":1.0,"b":1.5,"c":1e2}`,
+			equal: true,
+		},
+		{
+			name:  "large integers",
+			a:     `{"id":9007199254740993}`,
+			b:     `{"id":9007199254740992}`,
+			equal: false,
+		},
+		{
+			name:  "different values",
+			a:     `{"a":"1","b":"2"}`,
+			b:     `{"a":"1","b":"3"}`,
+			equal: false,
+		},
+		{
+			name:  "different numbers",
+			a:     `{"a":7}`,
+			b:     `{"a":7.5}`,
+			equal: false,
+		},
+		{
+			name:  "number and string",
+			a:     `{"a":7}`,
+			b:     `{"a":"7"}`,
+			equal: false,
+		},
+		{
+			name:  "numeric search value and string",
+			a:     `{"AND":[{"SEARCH_FIELD":"CVSS_SCORE","SEARCH_TYPE":"GTE","SEARCH_VALUE":"7.0"}]}`,
+			b:     `{"AND":[{"SEARCH_FIELD":"CVSS_SCORE","SEARCH_TYPE":"GTE","SEARCH_VALUE":7}]}`,
+			equal: true,
+		},
+		{
+			name:  "numeric search value strings",
+			a:     `{"SEARCH_VALUE":"7"}`,
+			b:     `{"SEARCH_VALUE":"7.0"}`,
+			equal: true,
+		},
+		{
+			name:  "different numeric search values",
+			a:     `{"SEARCH_VALUE":"7"}`,
+			b:     `{"SEARCH_VALUE":8}`,
+			equal: false,
+		},
+		{
+			name:  "non-numeric search values",
+			a:     `{"SEARCH_VALUE":"HIGH"}`,
+			b:     `{"SEARCH_VALUE":"high"}`,
+			equal: false,
+		},
+		{
+			name:  "missing key",
+			a:     `{"a":"1","b":null}`,
+			b:     `{"a":"1"}`,
+			equal: false,
+		},
+		{
+			name:  "arrays with different order",
+			a:     `{"AND":[{"SEARCH_FIELD":"b"},{"SEARCH_FIELD":"a"}]}`,
+			b:     `{"AND":[{"SEARCH_FIELD":"a"},{"SEARCH_FIELD":"b"}]}`,
+			equal: false,
+		},
+		{
+			name:  "invalid JSON compared verbatim",
+			a:     `{invalid json`,
+			b:     `{invalid json`,
+			equal: true,
+		},
+		{
+			name:  "invalid and valid JSON",
+			a:     `{invalid json`,
+			b:     `{"a":"1"}`,
+			equal: false,
+		},
+		{
+			name:  "trailing data",
+			a:     `{"a":"1"} {"b":"2"}`,
+			b:     `{"a":"1"}`,
+			equal: false,
+		},
+		{
+			name:  "non-JSON strings",
+			a:     "Y29uZGl0aW9u",
+			b:     "ZXhjZXB0aW9u",
+			equal: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := JSONStringsEqual(tc.a, tc.b); got != tc.equal {
+				t.Errorf("JSONStringsEqual(%q, %q) = %t, want %t", tc.a, tc.b, got, tc.equal)
+			}
+			if got := JSONStringsEqual(tc.b, tc.a); got != tc.equal {
+				t.Errorf("JSONStringsEqual(%q, %q) = %t, want %t", tc.b, tc.a, got, tc.equal)
+			}
+		})
+	}
+}
+
+func TestNormalizedJSON_StringSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	equal, diags := NewNormalizedJSONValue(`{"b":2,"a":1}`).StringSemanticEquals(ctx, NewNormalizedJSONValue(`{"a":1.0,"b":2}`))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !equal {
+		t.Error("expected values to be semantically equal")
+	}
+
+	equal, diags = NewNormalizedJSONValue(`{"a":1}`).StringSemanticEquals(ctx, NewNormalizedJSONValue(`{"a":2}`))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if equal {
+		t.Error("expected values not to be semantically equal")
+	}
+
+	_, diags = NewNormalizedJSONValue(`{"a":1}`).StringSemanticEquals(ctx, types.StringValue(`{"a":1}`))
+	if !diags.HasError() {
+		t.Error("expected an error when comparing with a different value type")
+	}
+}
+
+func TestNormalizedJSON_Equal(t *testing.T) {
+	a := NewNormalizedJSONValue(`{"a":1}`)
+
+	if !a.Equal(NewNormalizedJSONValue(`{"a":1}`)) {
+		t.Error("expected identical values to be equal")
+	}
+	if a.Equal(NewNormalizedJSONValue(`{ "a": 1 }`)) {
+		t.Error("expected values with different formatting not to be equal")
+	}
+	if a.Equal(types.StringValue(`{"a":1}`)) {
+		t.Error("expected values of different types not to be equal")
+	}
+}
+
+func TestNormalizedJSONType_ValueFromTerraform(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		in       tftypes.Value
+		expected NormalizedJSON
+	}{
+		{
+			name:     "known",
+			in:       tftypes.NewValue(tftypes.String, `{"a":1}`),
+			expected: NewNormalizedJSONValue(`{"a":1}`),
+		},
+		{
+			name:     "null",
+			in:       tftypes.NewValue(tftypes.String, nil),
+			expected: NewNormalizedJSONNull(),
+		},
+		{
+			name:     "unknown",
+			in:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: NewNormalizedJSONUnknown(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NormalizedJSONType{}.ValueFromTerraform(ctx, tc.in)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("ValueFromTerraform() = %v, want %v", got, tc.expected)
+			}
+			if !got.Type(ctx).Equal(NormalizedJSONType{}) {
+				t.Errorf("unexpected value type %s", got.Type(ctx))
+			}
+		})
+	}
+}
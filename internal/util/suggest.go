@@ -0,0 +1,57 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"strings"
+)
+
+// ClosestMatch returns the candidate most similar to value, ignoring case,
+// for use in "did you mean" suggestions. No candidate is returned if none of
+// them is within an edit distance of a third of the length of value (and at
+// least 1).
+func ClosestMatch(value string, candidates []string) (string, bool) {
+	value = strings.ToLower(value)
+	maxDistance := max(1, len(value)/3)
+
+	var (
+		closest      string
+		bestDistance = maxDistance + 1
+	)
+	for _, candidate := range candidates {
+		distance := levenshteinDistance(value, strings.ToLower(candidate))
+		if distance < bestDistance {
+			closest = candidate
+			bestDistance = distance
+		}
+	}
+
+	return closest, bestDistance <= maxDistance
+}
+
+// levenshteinDistance returns the minimum number of single character
+// insertions, deletions and substitutions required to change a into b.
+func levenshteinDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+
+	previous := make([]int, len(t)+1)
+	current := make([]int, len(t)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		current[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(t)]
+}
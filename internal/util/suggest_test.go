@@ -0,0 +1,72 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClosestMatch(t *testing.T) {
+	candidates := []string{"SEVERITY", "CATEGORY", "DOMAIN", "ALERT_NAME"}
+
+	tests := []struct {
+		name       string
+		value      string
+		candidates []string
+		expected   string
+		found      bool
+	}{
+		{
+			name:       "missing character",
+			value:      "SEVERTY",
+			candidates: candidates,
+			expected:   "SEVERITY",
+			found:      true,
+		},
+		{
+			name:       "transposed characters",
+			value:      "DOMIAN",
+			candidates: candidates,
+			expected:   "DOMAIN",
+			found:      true,
+		},
+		{
+			name:       "case is ignored",
+			value:      "category",
+			candidates: candidates,
+			expected:   "CATEGORY",
+			found:      true,
+		},
+		{
+			name:       "no similar candidate",
+			value:      "HOSTNAME",
+			candidates: candidates,
+			found:      false,
+		},
+		{
+			name:  "no candidates",
+			value: "SEVERITY",
+			found: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, found := ClosestMatch(tt.value, tt.candidates)
+			assert.Equal(t, tt.found, found)
+			if tt.found {
+				assert.Equal(t, tt.expected, match)
+			}
+		})
+	}
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	assert.Equal(t, 0, levenshteinDistance("", ""))
+	assert.Equal(t, 3, levenshteinDistance("", "abc"))
+	assert.Equal(t, 1, levenshteinDistance("severity", "severty"))
+	assert.Equal(t, 3, levenshteinDistance("kitten", "sitting"))
+}
@@ -0,0 +1,50 @@
+// Copyright (c) Palo Alto Networks, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// StringIsValidRegularExpression returns a validator which ensures that a
+// configured string value compiles as a Go (RE2) regular expression. Null and
+// unknown values are not validated.
+func StringIsValidRegularExpression() validator.String {
+	return stringIsValidRegularExpression{}
+}
+
+var _ validator.String = stringIsValidRegularExpression{}
+
+// stringIsValidRegularExpression validates that a string attribute value is a
+// valid regular expression.
+type stringIsValidRegularExpression struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v stringIsValidRegularExpression) Description(ctx context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v stringIsValidRegularExpression) MarkdownDescription(ctx context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+// ValidateString performs the validation logic for the validator.
+func (v stringIsValidRegularExpression) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("The value %q is not a valid regular expression: %s", req.ConfigValue.ValueString(), err.Error()),
+		)
+	}
+}
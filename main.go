@@ -7,10 +7,13 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/export"
 	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/provider"
+	"github.com/PaloAltoNetworks/terraform-provider-cortexcloud/internal/transport"
 )
 
 var (
@@ -32,9 +35,28 @@ func logBuildInfo() {
 		GitCommit, CortexServerVersion, CortexPAPIVersion, GoVersion, BuildDate)
 }
 
+// closeTraceSinks closes the HTTP trace files opened by the provider before
+// the process exits.
+func closeTraceSinks() {
+	if err := transport.CloseTraceSinks(); err != nil {
+		log.Printf("%s", err.Error())
+	}
+}
+
 func main() {
 	logBuildInfo()
 
+	// Generate configuration from an existing tenant instead of serving
+	// the provider when invoked as `terraform-provider-cortexcloud export`
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		err := export.Run(context.Background(), os.Args[2:], os.Stdout)
+		closeTraceSinks()
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers (i.e. delve)")
@@ -47,6 +69,7 @@ func main() {
 	}
 
 	err := providerserver.Serve(context.Background(), provider.New(GitCommit), opts)
+	closeTraceSinks()
 
 	if err != nil {
 		log.Fatal(err.Error())